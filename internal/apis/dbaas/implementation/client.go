@@ -1,7 +1,10 @@
 package implementation
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"terraform-provider-infomaniak/internal/apis/dbaas"
@@ -68,6 +71,44 @@ func (client *Client) GetDBaaS(publicCloudId int64, publicCloudProjectId int64,
 		return nil, err
 	}
 
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) ListDBaaS(publicCloudId int64, publicCloudProjectId int64, filter dbaas.DBaaSFilter) ([]*dbaas.DBaaS, error) {
+	var result helpers.NormalizedApiResponse[[]*dbaas.DBaaS]
+
+	builder := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetResult(&result).
+		SetError(&result)
+
+	if filter.Type != nil {
+		builder = builder.SetQueryParam("filter[type]", *filter.Type)
+	}
+	if filter.Region != nil {
+		builder = builder.SetQueryParam("filter[region]", *filter.Region)
+	}
+	if filter.Name != nil {
+		builder = builder.SetQueryParam("filter[name]", *filter.Name)
+	}
+	if filter.Status != nil {
+		builder = builder.SetQueryParam("filter[status]", *filter.Status)
+	}
+
+	resp, err := builder.Get(EndpointDatabases)
+	if err != nil {
+		return nil, err
+	}
+
 	if resp.IsError() {
 		return nil, result.Error
 	}
@@ -139,6 +180,50 @@ func (client *Client) DeleteDBaaS(publicCloudId int64, publicCloudProjectId int6
 	return result.Data, nil
 }
 
+func (client *Client) ResetPassword(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, password string) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetBody(map[string]string{"password": password}).
+		SetResult(&result).
+		SetError(&result).
+		Post(EndpointDatabaseResetPassword)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) UpgradeDBaaS(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, version string) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetBody(map[string]string{"version": version}).
+		SetResult(&result).
+		SetError(&result).
+		Post(EndpointDatabaseUpgrade)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
 func (client *Client) PatchIpFilters(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, filters dbaas.AllowedCIDRs) (bool, error) {
 	var result helpers.NormalizedApiResponse[bool]
 
@@ -184,7 +269,8 @@ func (client *Client) PutConfiguration(publicCloudId int64, publicCloudProjectId
 }
 
 func (client *Client) GetConfiguration(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) (map[string]any, error) {
-	var result helpers.NormalizedApiResponse[map[string]any]
+	// Values are kept raw so that numbers are not rounded through float64
+	var result helpers.NormalizedApiResponse[map[string]json.RawMessage]
 
 	resp, err := client.resty.R().
 		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
@@ -201,7 +287,18 @@ func (client *Client) GetConfiguration(publicCloudId int64, publicCloudProjectId
 		return nil, result.Error
 	}
 
-	return result.Data, nil
+	configuration := make(map[string]any, len(result.Data))
+	for key, raw := range result.Data {
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		configuration[key] = value
+	}
+
+	return configuration, nil
 }
 
 func (client *Client) GetIpFilters(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) ([]string, error) {
@@ -314,6 +411,319 @@ func (client *Client) DeleteDBaasScheduleBackup(publicCloudId int64, publicCloud
 	return result.Data, nil
 }
 
+func (client *Client) CreateBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) (string, error) {
+	var result helpers.NormalizedApiResponse[string]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetResult(&result).
+		SetError(&result).
+		Post(EndpointDatabaseBackups)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.IsError() {
+		return "", result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) GetBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, backupId string) (*dbaas.DBaaSBackup, error) {
+	var result helpers.NormalizedApiResponse[*dbaas.DBaaSBackup]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetPathParam("backup_id", backupId).
+		SetResult(&result).
+		SetError(&result).
+		Get(EndpointDatabaseBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) ListBackups(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) ([]*dbaas.DBaaSBackup, error) {
+	var result helpers.NormalizedApiResponse[[]*dbaas.DBaaSBackup]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetResult(&result).
+		SetError(&result).
+		Get(EndpointDatabaseBackups)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) DeleteBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, backupId string) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetPathParam("backup_id", backupId).
+		SetResult(&result).
+		SetError(&result).
+		Delete(EndpointDatabaseBackup)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return false, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) CreateRestore(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *dbaas.DBaaSRestore) (*dbaas.DBaaSRestore, error) {
+	var result helpers.NormalizedApiResponse[*dbaas.DBaaSRestore]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetBody(input).
+		SetResult(&result).
+		SetError(&result).
+		Post(EndpointDatabaseRestores)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) GetRestore(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, restoreId string) (*dbaas.DBaaSRestore, error) {
+	var result helpers.NormalizedApiResponse[*dbaas.DBaaSRestore]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetPathParam("restore_id", restoreId).
+		SetResult(&result).
+		SetError(&result).
+		Get(EndpointDatabaseRestore)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) CreateDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *dbaas.DBaaSDatabase) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetBody(input).
+		SetResult(&result).
+		SetError(&result).
+		Post(EndpointDatabaseDatabases)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) GetDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (*dbaas.DBaaSDatabase, error) {
+	var result helpers.NormalizedApiResponse[*dbaas.DBaaSDatabase]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetPathParam("database_name", name).
+		SetResult(&result).
+		SetError(&result).
+		Get(EndpointDatabaseDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) DeleteDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetPathParam("database_name", name).
+		SetResult(&result).
+		SetError(&result).
+		Delete(EndpointDatabaseDatabase)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return false, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) CreateUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *dbaas.DBaaSUser) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetBody(input).
+		SetResult(&result).
+		SetError(&result).
+		Post(EndpointDatabaseUsers)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) GetUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (*dbaas.DBaaSUser, error) {
+	var result helpers.NormalizedApiResponse[*dbaas.DBaaSUser]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetPathParam("user_name", name).
+		SetResult(&result).
+		SetError(&result).
+		Get(EndpointDatabaseUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) UpdateUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string, input *dbaas.DBaaSUser) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetPathParam("user_name", name).
+		SetBody(input).
+		SetResult(&result).
+		SetError(&result).
+		Patch(EndpointDatabaseUser)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
+func (client *Client) DeleteUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("dbaas_id", fmt.Sprint(dbaasId)).
+		SetPathParam("user_name", name).
+		SetResult(&result).
+		SetError(&result).
+		Delete(EndpointDatabaseUser)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return false, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
 func (client *Client) GetDbaasRegions() ([]string, error) {
 	var result helpers.NormalizedApiResponse[[]string]
 
@@ -350,7 +760,53 @@ func (client *Client) GetDbaasTypes() ([]*dbaas.DbaasType, error) {
 	return result.Data, nil
 }
 
+func (client *Client) GetConfigurationSchema(dbType string, version string) ([]*dbaas.DBaaSConfigurationParameter, error) {
+	var result helpers.NormalizedApiResponse[[]*dbaas.DBaaSConfigurationParameter]
+
+	resp, err := client.resty.R().
+		SetPathParam("type", dbType).
+		SetPathParam("version", version).
+		SetResult(&result).
+		SetError(&result).
+		Get(EndpointDbaasDataConfigurations)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, helpers.NotFoundError(result.Error)
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
 func (client *Client) GetDbaasPack(params dbaas.PackFilter) (*dbaas.Pack, error) {
+	data, err := client.ListDbaasPacks(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("pack not found")
+	}
+
+	if len(data) != 1 {
+		packs := strings.Builder{}
+		for _, pack := range data {
+			packs.WriteString(pack.Name)
+			packs.WriteString(", ")
+		}
+		return nil, fmt.Errorf("multiple packs found, please refine your search\nfound packs: %s", packs.String())
+	}
+
+	return data[0], nil
+}
+
+func (client *Client) ListDbaasPacks(params dbaas.PackFilter) ([]*dbaas.Pack, error) {
 	var result helpers.NormalizedApiResponse[[]*dbaas.Pack]
 
 	builder := client.resty.R().
@@ -391,19 +847,5 @@ func (client *Client) GetDbaasPack(params dbaas.PackFilter) (*dbaas.Pack, error)
 		return nil, result.Error
 	}
 
-	data := result.Data
-	if len(data) == 0 {
-		return nil, fmt.Errorf("pack not found")
-	}
-
-	if len(data) != 1 {
-		packs := strings.Builder{}
-		for _, pack := range data {
-			packs.WriteString(pack.Name)
-			packs.WriteString(", ")
-		}
-		return nil, fmt.Errorf("multiple packs found, please refine your search\nfound packs: %s", packs.String())
-	}
-
-	return data[0], nil
+	return result.Data, nil
 }
@@ -1,7 +1,9 @@
 package implementation
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/url"
 	"strings"
 	"terraform-provider-infomaniak/internal/apis/dbaas"
 	"terraform-provider-infomaniak/internal/apis/helpers"
@@ -17,6 +19,19 @@ const (
 
 	TestEndpointDBaaSes = `=~^/1/public_clouds/\d+/projects/\d+/dbaas\z`
 	TestEndpointDBaaS   = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+\z`
+	TestEndpointBackups = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/backups\z`
+	TestEndpointBackup  = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/backups/[^/]+\z`
+
+	TestEndpointRestores      = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/restores\z`
+	TestEndpointDatabase      = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/databases/[^/]+\z`
+	TestEndpointUpgrade       = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/upgrade\z`
+	TestEndpointResetPassword = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/reset_password\z`
+	TestEndpointUsers         = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/users\z`
+	TestEndpointUser          = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/users/[^/]+\z`
+
+	TestEndpointConfiguration       = `=~^/1/public_clouds/\d+/projects/\d+/dbaas/\d+/configurations\z`
+	TestEndpointConfigurationSchema = `=~^/1/public_clouds/dbaas/types/[^/]+/versions/[^/]+/configurations\z`
+	TestEndpointPacks               = `=~^/1/public_clouds/dbaas/packs\z`
 )
 
 func NewSuccessResponse[K any](data K) helpers.NormalizedApiResponse[K] {
@@ -54,5 +69,279 @@ var _ = Describe("DBaaS API Client", func() {
 			_, err := client.GetDBaaS(1, 1, 1)
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+
+		It("should report a missing DBaaS as not found", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", TestEndpointDBaaS, httpmock.NewJsonResponderOrPanic(404, helpers.NormalizedApiResponse[any]{
+				Result: "error",
+				Error:  &helpers.ApiError{Description: "Object not found"},
+			}))
+
+			_, err := client.GetDBaaS(1, 1, 12)
+			Expect(err).To(MatchError(helpers.ErrNotFound))
+		})
+
+		It("should be able to create and list backups", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("POST", TestEndpointBackups, httpmock.NewJsonResponderOrPanic(200, NewSuccessResponse("backup-1")))
+			httpmock.RegisterResponder("GET", TestEndpointBackups, httpmock.NewJsonResponderOrPanic(200, NewSuccessResponse([]*dbaas.DBaaSBackup{
+				{Id: "backup-1", Status: "completed", Location: "s3://backups/backup-1"},
+			})))
+
+			backupId, err := client.CreateBackup(1, 1, 12)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(backupId).To(Equal("backup-1"))
+
+			backups, err := client.ListBackups(1, 1, 12)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(backups).To(HaveLen(1))
+			Expect(backups[0].Location).To(Equal("s3://backups/backup-1"))
+		})
+
+		It("should report a missing backup as not found", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", TestEndpointBackup, httpmock.NewJsonResponderOrPanic(404, helpers.NormalizedApiResponse[any]{
+				Result: "error",
+				Error:  &helpers.ApiError{Description: "Object not found"},
+			}))
+
+			_, err := client.GetBackup(1, 1, 12, "backup-1")
+			Expect(err).To(MatchError(helpers.ErrNotFound))
+		})
+
+		It("should send the restore source and return the new service", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			var sent dbaas.DBaaSRestore
+			httpmock.RegisterResponder("POST", TestEndpointRestores, func(req *http.Request) (*http.Response, error) {
+				if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+					return nil, err
+				}
+
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(&dbaas.DBaaSRestore{
+					Id:           "restore-1",
+					BackupSource: sent.BackupSource,
+					Status:       "running",
+					NewService:   &dbaas.DBaaSCreateInfo{Id: 13, RootPassword: "secret"},
+				}))
+			})
+
+			restore, err := client.CreateRestore(1, 1, 12, &dbaas.DBaaSRestore{BackupSource: "backup-1"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sent.BackupSource).To(Equal("backup-1"))
+			Expect(sent.InPlace).To(BeFalse())
+			Expect(restore.NewService.Id).To(Equal(int64(13)))
+		})
+
+		It("should send the user grants", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			var sent dbaas.DBaaSUser
+			httpmock.RegisterResponder("POST", TestEndpointUsers, func(req *http.Request) (*http.Response, error) {
+				if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+					return nil, err
+				}
+
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(true))
+			})
+
+			ok, err := client.CreateUser(1, 1, 12, &dbaas.DBaaSUser{
+				Name:     "app",
+				Password: "secret",
+				Grants: []*dbaas.DBaaSGrant{
+					{Database: "app", Privileges: []string{"SELECT", "INSERT"}},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(sent.Grants).To(HaveLen(1))
+			Expect(sent.Grants[0].Privileges).To(ConsistOf("SELECT", "INSERT"))
+		})
+
+		It("should send an empty list of grants to revoke them", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			var sent map[string]any
+			httpmock.RegisterResponder("PATCH", TestEndpointUser, func(req *http.Request) (*http.Response, error) {
+				if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+					return nil, err
+				}
+
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(true))
+			})
+
+			ok, err := client.UpdateUser(1, 1, 12, "app", &dbaas.DBaaSUser{Grants: []*dbaas.DBaaSGrant{}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(sent).To(HaveKeyWithValue("grants", BeEmpty()))
+			Expect(sent).NotTo(HaveKey("password"))
+		})
+
+		It("should send the new admin password", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			var sent map[string]string
+			httpmock.RegisterResponder("POST", TestEndpointResetPassword, func(req *http.Request) (*http.Response, error) {
+				if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+					return nil, err
+				}
+
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(true))
+			})
+
+			ok, err := client.ResetPassword(1, 1, 12, "n3w-p@ssword")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(sent).To(HaveKeyWithValue("password", "n3w-p@ssword"))
+		})
+
+		It("should send the target version of an upgrade", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			var sent map[string]string
+			httpmock.RegisterResponder("POST", TestEndpointUpgrade, func(req *http.Request) (*http.Response, error) {
+				if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+					return nil, err
+				}
+
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(true))
+			})
+
+			ok, err := client.UpgradeDBaaS(1, 1, 12, "8.4")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(sent).To(HaveKeyWithValue("version", "8.4"))
+		})
+
+		It("should send the filters of a DBaaS list", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			expectedResult := []*dbaas.DBaaS{
+				{Id: 12, Name: "app", Type: "mysql", Region: "dc4-a", Status: "ready"},
+			}
+
+			var query url.Values
+			httpmock.RegisterResponder("GET", TestEndpointDBaaSes, func(req *http.Request) (*http.Response, error) {
+				query = req.URL.Query()
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(expectedResult))
+			})
+
+			dbType, name := "mysql", "app"
+			list, err := client.ListDBaaS(1, 1, dbaas.DBaaSFilter{Type: &dbType, Name: &name})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(list).To(Equal(expectedResult))
+			Expect(query).To(Equal(url.Values{
+				"filter[type]": {"mysql"},
+				"filter[name]": {"app"},
+			}))
+		})
+
+		It("should list every pack matching the filters", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			expectedResult := []*dbaas.Pack{
+				{ID: 3, Type: "mysql", Group: "business", Name: "business-1", Instances: 3, CPU: 4, RAM: 16, Storage: 80},
+				{ID: 4, Type: "mysql", Group: "business", Name: "business-2", Instances: 3, CPU: 8, RAM: 32, Storage: 160},
+			}
+
+			var query url.Values
+			httpmock.RegisterResponder("GET", TestEndpointPacks, func(req *http.Request) (*http.Response, error) {
+				query = req.URL.Query()
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(expectedResult))
+			})
+
+			group, instances := "business", int64(3)
+			packs, err := client.ListDbaasPacks(dbaas.PackFilter{DbType: "mysql", Group: &group, Instances: &instances})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(packs).To(Equal(expectedResult))
+			Expect(query).To(Equal(url.Values{
+				"filter[type]":      {"mysql"},
+				"filter[groups][]":  {"business"},
+				"filter[instances]": {"3"},
+			}))
+
+			_, err = client.GetDbaasPack(dbaas.PackFilter{DbType: "mysql", Group: &group})
+			Expect(err).Should(MatchError(ContainSubstring("multiple packs found")))
+		})
+
+		It("should get the configuration without rounding numbers", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", TestEndpointConfiguration, func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(200, `{"result":"success","data":{"innodb_buffer_pool_size":9007199254740993,"long_query_time":0.1,"sql_mode":["NO_ZERO_DATE"]}}`)
+				resp.Header.Set("Content-Type", "application/json")
+				return resp, nil
+			})
+
+			configuration, err := client.GetConfiguration(1, 1, 12)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(configuration).To(Equal(map[string]any{
+				"innodb_buffer_pool_size": json.Number("9007199254740993"),
+				"long_query_time":         json.Number("0.1"),
+				"sql_mode":                []any{"NO_ZERO_DATE"},
+			}))
+		})
+
+		It("should get the configuration catalog of an engine version", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			maxConnections := 10000.0
+			expectedResult := []*dbaas.DBaaSConfigurationParameter{
+				{Name: "max_connections", Type: "integer", Max: &maxConnections},
+				{Name: "innodb_buffer_pool_size", Type: "integer", RestartRequired: true},
+			}
+
+			var requested string
+			httpmock.RegisterResponder("GET", TestEndpointConfigurationSchema, func(req *http.Request) (*http.Response, error) {
+				requested = req.URL.Path
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(expectedResult))
+			})
+
+			catalog, err := client.GetConfigurationSchema("mysql", "8.0")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(catalog).To(Equal(expectedResult))
+			Expect(requested).To(Equal("/1/public_clouds/dbaas/types/mysql/versions/8.0/configurations"))
+		})
+
+		It("should report a missing configuration catalog as not found", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", TestEndpointConfigurationSchema, httpmock.NewJsonResponderOrPanic(404, helpers.NormalizedApiResponse[any]{
+				Result: "error",
+				Error:  &helpers.ApiError{Description: "Object not found"},
+			}))
+
+			_, err := client.GetConfigurationSchema("mysql", "4.0")
+			Expect(err).To(MatchError(helpers.ErrNotFound))
+		})
+
+		It("should report a missing database as not found", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", TestEndpointDatabase, httpmock.NewJsonResponderOrPanic(404, helpers.NormalizedApiResponse[any]{
+				Result: "error",
+				Error:  &helpers.ApiError{Description: "Object not found"},
+			}))
+
+			_, err := client.GetDatabase(1, 1, 12, "app")
+			Expect(err).To(MatchError(helpers.ErrNotFound))
+		})
 	})
 })
@@ -9,6 +9,8 @@ var (
 
 	EndpointDatabases             = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas"
 	EndpointDatabase              = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}"
+	EndpointDatabaseResetPassword = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/reset_password"
+	EndpointDatabaseUpgrade       = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/upgrade"
 	EndpointDatabaseConfiguration = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/configurations"
 
 	EndpointDatabaseIpFilter = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/ip_filters"
@@ -16,7 +18,21 @@ var (
 	EndpointDatabaseBackupSchedules = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/backup_schedules"
 	EndpointDatabaseBackupSchedule  = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/backup_schedules/{schedule_id}"
 
+	EndpointDatabaseBackups = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/backups"
+	EndpointDatabaseBackup  = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/backups/{backup_id}"
+
+	EndpointDatabaseRestores = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/restores"
+	EndpointDatabaseRestore  = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/restores/{restore_id}"
+
+	EndpointDatabaseDatabases = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/databases"
+	EndpointDatabaseDatabase  = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/databases/{database_name}"
+
+	EndpointDatabaseUsers = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/users"
+	EndpointDatabaseUser  = "/1/public_clouds/{public_cloud_id}/projects/{public_cloud_project_id}/dbaas/{dbaas_id}/users/{user_name}"
+
 	EndpointDbaasDataRegion = "/1/public_clouds/dbaas/regions"
 	EndpointDbaasDataPacks  = "/1/public_clouds/dbaas/packs"
 	EndpointDbaasDataTypes  = "/1/public_clouds/dbaas/types"
+
+	EndpointDbaasDataConfigurations = "/1/public_clouds/dbaas/types/{type}/versions/{version}/configurations"
 )
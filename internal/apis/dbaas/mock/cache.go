@@ -5,9 +5,11 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/apis/helpers"
 	"time"
 )
 
@@ -20,7 +22,7 @@ var (
 	mockedApiStatePath = path.Join(os.TempDir(), "terraform-provider-infomaniak-dbaas")
 	mockedApiState     = make(map[string][]byte)
 
-	ErrKeyNotFound  = errors.New("key not found")
+	ErrKeyNotFound  = fmt.Errorf("key %w", helpers.ErrNotFound)
 	ErrDuplicateKey = errors.New("duplicate key found")
 )
 
@@ -1,28 +1,59 @@
 package mock
 
 import (
+	"cmp"
 	"fmt"
 	"math/rand/v2"
+	"slices"
+	"strings"
 	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"time"
 )
 
 // Ensure that our client implements Api
 var (
 	_ dbaas.Api = (*Client)(nil)
+
+	mockedBackups   = make(map[string][]*dbaas.DBaaSBackup)
+	mockedRestores  = make(map[string][]*dbaas.DBaaSRestore)
+	mockedDatabases = make(map[string][]*dbaas.DBaaSDatabase)
+	mockedUsers     = make(map[string][]*dbaas.DBaaSUser)
+
+	// BackupStatus is the status of the backups created from now on, e.g. "failed" to make waiting for them fail
+	BackupStatus = "completed"
+	// RestoreStatus is the status of the restores created from now on, e.g. "failed" to make waiting for them fail
+	RestoreStatus = "completed"
 )
 
+// mockedCa is a self-signed certificate expiring on 2036-10-16T10:19:22Z
+const mockedCa = `-----BEGIN CERTIFICATE-----
+MIIBhDCCASugAwIBAgIUSRBAIlAx7dmQsc7CPW6v/SYaRRYwCgYIKoZIzj0EAwIw
+GDEWMBQGA1UEAwwNREJhYVMgTW9jayBDQTAeFw0yNjEwMTkxMDE5MjJaFw0zNjEw
+MTYxMDE5MjJaMBgxFjAUBgNVBAMMDURCYWFTIE1vY2sgQ0EwWTATBgcqhkjOPQIB
+BggqhkjOPQMBBwNCAAQ7kHec6w2jU7yotRIbFtjmMJzETxeAVoArjkxED62Z+Z41
+CC2BYe8U2CMosCoaRl2loDaS1suUM3aULSlpLRVSo1MwUTAdBgNVHQ4EFgQUhxR+
+JrWxKfVT1SRYIqgknJQlvmYwHwYDVR0jBBgwFoAUhxR+JrWxKfVT1SRYIqgknJQl
+vmYwDwYDVR0TAQH/BAUwAwEB/zAKBggqhkjOPQQDAgNHADBEAiEAlyk9yjY0bY1a
+z/dus9TGqopJh8W3r4nvMVt9QVPygeACH1zPFliOZeJW6w3VRbfSnCfyD0fcQE1C
++Al/2iITJqI=
+-----END CERTIFICATE-----`
+
 type Client struct{}
 
 func (c *Client) GetPacks() (map[string][]*dbaas.DBaaSPack, error) {
 	return map[string][]*dbaas.DBaaSPack{
 		"mysql": {
 			{
-				Id:   1,
-				Name: "essential-1",
+				Id:      1,
+				Name:    "essential-1",
+				Type:    "mysql",
+				Storage: 20,
 			},
 			{
-				Id:   2,
-				Name: "essential-2",
+				Id:      2,
+				Name:    "essential-2",
+				Type:    "mysql",
+				Storage: 40,
 			},
 		},
 	}, nil
@@ -75,9 +106,11 @@ func (c *Client) CreateDBaaS(input *dbaas.DBaaS) (*dbaas.DBaaSCreateInfo, error)
 			Port:     "3306",
 			User:     "root",
 			Password: "p@sSw0rd",
-			Ca:       "This is totally a valid CA",
+			Ca:       mockedCa,
 		},
 		KubernetesIdentifier: "pck-zbeet07",
+		CreatedAt:            uint64(time.Now().Unix()),
+		Tags:                 input.Tags,
 	}
 	obj.Id = rand.Int64()
 
@@ -96,7 +129,45 @@ func (c *Client) CreateDBaasScheduleBackup(publicCloudId int64, publicCloudProje
 
 // DeleteDBaaS implements dbaas.Api.
 func (c *Client) DeleteDBaaS(publicCloudId int64, publicCloudProjectId int64, DBaaSId int64) (bool, error) {
-	return true, nil
+	var obj = dbaas.DBaaS{
+		Project: dbaas.DBaaSProject{
+			PublicCloudId: publicCloudId,
+			ProjectId:     publicCloudProjectId,
+		},
+		Id: DBaaSId,
+	}
+
+	return true, removeFromCache(&obj)
+}
+
+// ResetPassword implements dbaas.Api.
+func (c *Client) ResetPassword(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, password string) (bool, error) {
+	obj, err := c.GetDBaaS(publicCloudId, publicCloudProjectId, dbaasId)
+	if err != nil {
+		return false, err
+	}
+
+	if password == "" {
+		return false, fmt.Errorf("dbaas is missing password")
+	}
+	obj.Connection.Password = password
+
+	return true, updateCache(obj)
+}
+
+// UpgradeDBaaS implements dbaas.Api.
+func (c *Client) UpgradeDBaaS(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, version string) (bool, error) {
+	obj, err := c.GetDBaaS(publicCloudId, publicCloudProjectId, dbaasId)
+	if err != nil {
+		return false, err
+	}
+
+	if version == "" {
+		return false, fmt.Errorf("dbaas is missing version")
+	}
+	obj.Version = version
+
+	return true, updateCache(obj)
 }
 
 // DeleteDBaasScheduleBackup implements dbaas.Api.
@@ -143,6 +214,37 @@ func (c *Client) GetDBaaS(publicCloudId int64, publicCloudProjectId int64, DBaaS
 	return obj, nil
 }
 
+// ListDBaaS implements dbaas.Api.
+func (c *Client) ListDBaaS(publicCloudId int64, publicCloudProjectId int64, filter dbaas.DBaaSFilter) ([]*dbaas.DBaaS, error) {
+	prefix := fmt.Sprintf("%d-%d-", publicCloudId, publicCloudProjectId)
+	matches := func(value string, expected *string) bool {
+		return expected == nil || *expected == value
+	}
+
+	list := make([]*dbaas.DBaaS, 0)
+	for key := range mockedApiState {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		obj, err := getFromCache[*dbaas.DBaaS](key)
+		if err != nil {
+			return nil, err
+		}
+		obj.Status = "ready"
+
+		if matches(obj.Type, filter.Type) && matches(obj.Region, filter.Region) && matches(obj.Name, filter.Name) && matches(obj.Status, filter.Status) {
+			list = append(list, obj)
+		}
+	}
+
+	slices.SortFunc(list, func(a, b *dbaas.DBaaS) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return list, nil
+}
+
 // GetDBaasScheduleBackup implements dbaas.Api.
 func (c *Client) GetDBaasScheduleBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, id int64) (*dbaas.DBaasBackupSchedule, error) {
 	return nil, nil
@@ -153,6 +255,38 @@ func (c *Client) GetDbaasPack(params dbaas.PackFilter) (*dbaas.Pack, error) {
 	return nil, nil
 }
 
+// ListDbaasPacks implements dbaas.Api.
+func (c *Client) ListDbaasPacks(params dbaas.PackFilter) ([]*dbaas.Pack, error) {
+	rates := func(chf, eur float64) dbaas.Rates {
+		return dbaas.Rates{
+			CHF: dbaas.Pricing{HourExclTax: chf, HourInclTax: chf * 1.081},
+			EUR: dbaas.Pricing{HourExclTax: eur, HourInclTax: eur * 1.081},
+		}
+	}
+
+	packs := []*dbaas.Pack{
+		{ID: 1, Type: "mysql", Group: "essential", Name: "essential-1", Instances: 1, CPU: 1, RAM: 4, Storage: 20, Rates: rates(0.05, 0.055)},
+		{ID: 2, Type: "mysql", Group: "essential", Name: "essential-2", Instances: 1, CPU: 2, RAM: 8, Storage: 40, Rates: rates(0.1, 0.11)},
+		{ID: 3, Type: "mysql", Group: "business", Name: "business-1", Instances: 3, CPU: 4, RAM: 16, Storage: 80, Rates: rates(0.45, 0.48)},
+		{ID: 4, Type: "mysql", Group: "business", Name: "business-2", Instances: 3, CPU: 8, RAM: 32, Storage: 160, Rates: rates(0.9, 0.95)},
+		{ID: 5, Type: "mysql", Group: "essential", Name: "essential-4", Instances: 1, CPU: 4, RAM: 16, Storage: 80, Rates: rates(0.2, 0.22)},
+	}
+
+	matches := func(pack *dbaas.Pack) bool {
+		return pack.Type == params.DbType &&
+			(params.Group == nil || *params.Group == pack.Group) &&
+			(params.Name == nil || *params.Name == pack.Name) &&
+			(params.Instances == nil || *params.Instances == pack.Instances) &&
+			(params.Cpu == nil || *params.Cpu == pack.CPU) &&
+			(params.Ram == nil || *params.Ram == pack.RAM) &&
+			(params.Storage == nil || *params.Storage == pack.Storage)
+	}
+
+	return slices.DeleteFunc(packs, func(pack *dbaas.Pack) bool {
+		return !matches(pack)
+	}), nil
+}
+
 // GetDbaasRegions implements dbaas.Api.
 func (c *Client) GetDbaasRegions() ([]string, error) {
 	return []string{"dc4-a", "dc5-a"}, nil
@@ -163,11 +297,32 @@ func (c *Client) GetDbaasTypes() ([]*dbaas.DbaasType, error) {
 	return []*dbaas.DbaasType{
 		{
 			Name:     "mysql",
-			Versions: []string{"8.0"},
+			Versions: []string{"5.7", "8.0", "8.4"},
 		},
 	}, nil
 }
 
+// GetConfigurationSchema implements dbaas.Api.
+func (c *Client) GetConfigurationSchema(dbType string, version string) ([]*dbaas.DBaaSConfigurationParameter, error) {
+	if dbType != "mysql" {
+		return nil, ErrKeyNotFound
+	}
+
+	bound := func(value float64) *float64 {
+		return &value
+	}
+
+	return []*dbaas.DBaaSConfigurationParameter{
+		{Name: "max_connections", Type: "integer", Min: bound(10), Max: bound(10000)},
+		{Name: "connect_timeout", Type: "integer", Min: bound(2), Max: bound(31536000)},
+		{Name: "long_query_time", Type: "float", Min: bound(0), Max: bound(3600)},
+		{Name: "slow_query_log", Type: "boolean"},
+		{Name: "transaction_isolation", Type: "string", Values: []string{"READ-UNCOMMITTED", "READ-COMMITTED", "REPEATABLE-READ", "SERIALIZABLE"}},
+		{Name: "sql_mode", Type: "list", Values: []string{"ANSI_QUOTES", "ERROR_FOR_DIVISION_BY_ZERO", "NO_ENGINE_SUBSTITUTION", "NO_ZERO_DATE", "ONLY_FULL_GROUP_BY", "STRICT_TRANS_TABLES"}},
+		{Name: "innodb_buffer_pool_size", Type: "integer", Min: bound(5242880), RestartRequired: true},
+	}, nil
+}
+
 // GetIpFilters implements dbaas.Api.
 func (c *Client) GetIpFilters(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) ([]string, error) {
 	return []string{"0.0.0.0/0"}, nil
@@ -185,9 +340,303 @@ func (c *Client) PutConfiguration(publicCloudId int64, publicCloudProjectId int6
 
 // UpdateDBaaS implements dbaas.Api.
 func (c *Client) UpdateDBaaS(input *dbaas.DBaaS) (bool, error) {
+	obj, err := getFromCache[*dbaas.DBaaS](input.Key())
+	if err != nil {
+		return false, err
+	}
+
+	if input.Tags != nil {
+		obj.Tags = input.Tags
+	}
+	if input.PackId != 0 && input.PackId != obj.PackId {
+		packs, _ := c.GetPacks()
+		index := slices.IndexFunc(packs[obj.Type], func(pack *dbaas.DBaaSPack) bool {
+			return pack.Id == input.PackId
+		})
+		if index < 0 {
+			return false, fmt.Errorf("dbaas pack not found")
+		}
+		if packs[obj.Type][index].Storage < obj.Pack.Storage {
+			return false, fmt.Errorf("dbaas storage cannot be shrunk")
+		}
+		obj.PackId = input.PackId
+		obj.Pack = packs[obj.Type][index]
+	}
+
+	return true, updateCache(obj)
+}
+
+// CreateBackup implements dbaas.Api.
+func (c *Client) CreateBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) (string, error) {
+	obj, err := c.GetDBaaS(publicCloudId, publicCloudProjectId, dbaasId)
+	if err != nil {
+		return "", err
+	}
+
+	now := uint64(time.Now().Unix())
+	backup := &dbaas.DBaaSBackup{
+		Id:          fmt.Sprintf("backup-%d", rand.Int64()),
+		Location:    fmt.Sprintf("s3://dbaas-backups/%s", obj.KubernetesIdentifier),
+		CreatedAt:   now,
+		CompletedAt: now,
+		Status:      BackupStatus,
+	}
+	mockedBackups[obj.Key()] = append(mockedBackups[obj.Key()], backup)
+
+	return backup.Id, nil
+}
+
+// GetBackup implements dbaas.Api.
+func (c *Client) GetBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, backupId string) (*dbaas.DBaaSBackup, error) {
+	backups, err := c.ListBackups(publicCloudId, publicCloudProjectId, dbaasId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, backup := range backups {
+		if backup.Id == backupId {
+			return backup, nil
+		}
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+// ListBackups implements dbaas.Api.
+func (c *Client) ListBackups(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) ([]*dbaas.DBaaSBackup, error) {
+	obj, err := c.GetDBaaS(publicCloudId, publicCloudProjectId, dbaasId)
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]*dbaas.DBaaSBackup, len(mockedBackups[obj.Key()]))
+	for i, backup := range mockedBackups[obj.Key()] {
+		copied := *backup
+		backups[i] = &copied
+	}
+
+	return backups, nil
+}
+
+// DeleteBackup implements dbaas.Api.
+func (c *Client) DeleteBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, backupId string) (bool, error) {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, publicCloudProjectId, dbaasId)
+	backups := mockedBackups[key]
+	index := slices.IndexFunc(backups, func(backup *dbaas.DBaaSBackup) bool {
+		return backup.Id == backupId
+	})
+	if index < 0 {
+		return false, ErrKeyNotFound
+	}
+
+	mockedBackups[key] = slices.Delete(backups, index, index+1)
+	return true, nil
+}
+
+// CreateRestore implements dbaas.Api.
+func (c *Client) CreateRestore(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *dbaas.DBaaSRestore) (*dbaas.DBaaSRestore, error) {
+	obj, err := c.GetDBaaS(publicCloudId, publicCloudProjectId, dbaasId)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.BackupSource != "" {
+		if _, err := c.GetBackup(publicCloudId, publicCloudProjectId, dbaasId, input.BackupSource); err != nil {
+			return nil, err
+		}
+	}
+	if input.PointInTime > uint64(time.Now().Unix()) {
+		return nil, fmt.Errorf("cannot restore a point in time in the future")
+	}
+
+	restore := &dbaas.DBaaSRestore{
+		Id:           fmt.Sprintf("restore-%d", rand.Int64()),
+		BackupSource: input.BackupSource,
+		PointInTime:  input.PointInTime,
+		InPlace:      input.InPlace,
+		CreatedAt:    uint64(time.Now().Unix()),
+		Status:       RestoreStatus,
+	}
+
+	if !input.InPlace {
+		target := &dbaas.DBaaS{
+			Project: obj.Project,
+			Region:  obj.Region,
+			Type:    obj.Type,
+			Version: obj.Version,
+			PackId:  obj.PackId,
+			Name:    obj.Name + "-restored",
+		}
+		if input.Target != nil {
+			target.Name = input.Target.Name
+			target.PackId = input.Target.PackId
+			target.Tags = input.Target.Tags
+		}
+
+		restore.NewService, err = c.CreateDBaaS(target)
+		if err != nil {
+			return nil, err
+		}
+	}
+	mockedRestores[obj.Key()] = append(mockedRestores[obj.Key()], restore)
+
+	return restore, nil
+}
+
+// GetRestore implements dbaas.Api.
+func (c *Client) GetRestore(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, restoreId string) (*dbaas.DBaaSRestore, error) {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, publicCloudProjectId, dbaasId)
+	for _, restore := range mockedRestores[key] {
+		if restore.Id == restoreId {
+			copied := *restore
+			return &copied, nil
+		}
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+// CreateDatabase implements dbaas.Api.
+func (c *Client) CreateDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *dbaas.DBaaSDatabase) (bool, error) {
+	obj, err := c.GetDBaaS(publicCloudId, publicCloudProjectId, dbaasId)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := c.GetDatabase(publicCloudId, publicCloudProjectId, dbaasId, input.Name); err == nil {
+		return false, fmt.Errorf("database %s already exists", input.Name)
+	}
+
+	database := *input
+	if database.CharacterSet == "" {
+		database.CharacterSet = "utf8mb4"
+	}
+	if database.Collation == "" {
+		database.Collation = "utf8mb4_0900_ai_ci"
+	}
+	mockedDatabases[obj.Key()] = append(mockedDatabases[obj.Key()], &database)
+
+	return true, nil
+}
+
+// GetDatabase implements dbaas.Api.
+func (c *Client) GetDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (*dbaas.DBaaSDatabase, error) {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, publicCloudProjectId, dbaasId)
+	for _, database := range mockedDatabases[key] {
+		if database.Name == name {
+			copied := *database
+			return &copied, nil
+		}
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+// DeleteDatabase implements dbaas.Api.
+func (c *Client) DeleteDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (bool, error) {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, publicCloudProjectId, dbaasId)
+	databases := mockedDatabases[key]
+	index := slices.IndexFunc(databases, func(database *dbaas.DBaaSDatabase) bool {
+		return database.Name == name
+	})
+	if index < 0 {
+		return false, ErrKeyNotFound
+	}
+
+	mockedDatabases[key] = slices.Delete(databases, index, index+1)
 	return true, nil
 }
 
+// CreateUser implements dbaas.Api.
+func (c *Client) CreateUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *dbaas.DBaaSUser) (bool, error) {
+	obj, err := c.GetDBaaS(publicCloudId, publicCloudProjectId, dbaasId)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := c.GetUser(publicCloudId, publicCloudProjectId, dbaasId, input.Name); err == nil {
+		return false, fmt.Errorf("user %s already exists", input.Name)
+	}
+	if input.Password == "" {
+		return false, fmt.Errorf("user is missing password")
+	}
+	if err := c.checkGrants(obj.Key(), input.Grants); err != nil {
+		return false, err
+	}
+
+	user := *input
+	mockedUsers[obj.Key()] = append(mockedUsers[obj.Key()], &user)
+
+	return true, nil
+}
+
+// GetUser implements dbaas.Api.
+func (c *Client) GetUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (*dbaas.DBaaSUser, error) {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, publicCloudProjectId, dbaasId)
+	for _, user := range mockedUsers[key] {
+		if user.Name == name {
+			// The password is never returned by the API
+			return &dbaas.DBaaSUser{
+				Name:   user.Name,
+				Grants: user.Grants,
+			}, nil
+		}
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+// UpdateUser implements dbaas.Api.
+func (c *Client) UpdateUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string, input *dbaas.DBaaSUser) (bool, error) {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, publicCloudProjectId, dbaasId)
+	index := slices.IndexFunc(mockedUsers[key], func(user *dbaas.DBaaSUser) bool {
+		return user.Name == name
+	})
+	if index < 0 {
+		return false, ErrKeyNotFound
+	}
+	if err := c.checkGrants(key, input.Grants); err != nil {
+		return false, err
+	}
+
+	user := mockedUsers[key][index]
+	if input.Password != "" {
+		user.Password = input.Password
+	}
+	user.Grants = input.Grants
+
+	return true, nil
+}
+
+// DeleteUser implements dbaas.Api.
+func (c *Client) DeleteUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (bool, error) {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, publicCloudProjectId, dbaasId)
+	users := mockedUsers[key]
+	index := slices.IndexFunc(users, func(user *dbaas.DBaaSUser) bool {
+		return user.Name == name
+	})
+	if index < 0 {
+		return false, ErrKeyNotFound
+	}
+
+	mockedUsers[key] = slices.Delete(users, index, index+1)
+	return true, nil
+}
+
+// checkGrants ensures every granted database exists, like the API does
+func (c *Client) checkGrants(key string, grants []*dbaas.DBaaSGrant) error {
+	for _, grant := range grants {
+		exists := slices.ContainsFunc(mockedDatabases[key], func(database *dbaas.DBaaSDatabase) bool {
+			return database.Name == grant.Database
+		})
+		if !exists {
+			return fmt.Errorf("database %s not found", grant.Database)
+		}
+	}
+
+	return nil
+}
+
 // UpdateDBaasScheduleBackup implements dbaas.Api.
 func (c *Client) UpdateDBaasScheduleBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, id int64, backupSchedules *dbaas.DBaasBackupSchedule) (bool, error) {
 	return true, nil
@@ -7,8 +7,10 @@ import (
 )
 
 type DBaaSPack struct {
-	Id   int64  `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
+	Id      int64  `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Storage int64  `json:"storage,omitempty"`
 }
 
 type DbaasType struct {
@@ -16,6 +18,17 @@ type DbaasType struct {
 	Versions []string `json:"versions,omitempty"`
 }
 
+// DBaaSConfigurationParameter describes a configuration key accepted by a database engine
+type DBaaSConfigurationParameter struct {
+	Name string `json:"name,omitempty"`
+	// Type is one of integer, float, boolean, string or list
+	Type            string   `json:"type,omitempty"`
+	Min             *float64 `json:"min,omitempty"`
+	Max             *float64 `json:"max,omitempty"`
+	Values          []string `json:"values,omitempty"`
+	RestartRequired bool     `json:"restart_required,omitempty"`
+}
+
 type PackFilter struct {
 	DbType    string
 	Group     *string
@@ -26,6 +39,14 @@ type PackFilter struct {
 	Storage   *int64
 }
 
+// DBaaSFilter narrows the DBaaS listed in a project, nil fields are not filtered on
+type DBaaSFilter struct {
+	Type   *string
+	Region *string
+	Name   *string
+	Status *string
+}
+
 type Pack struct {
 	ID        int64  `json:"id,omitempty"`
 	Type      string `json:"type,omitempty"`
@@ -61,6 +82,9 @@ type DBaaS struct {
 	KubernetesIdentifier string `json:"kube_identifier,omitempty"`
 	Region               string `json:"region,omitempty"`
 	Status               string `json:"status,omitempty"`
+	CreatedAt            uint64 `json:"created_at,omitempty"`
+
+	Tags map[string]string `json:"tags,omitzero"`
 }
 
 type AllowedCIDRs struct {
@@ -127,9 +151,32 @@ type DBaaSBackup struct {
 type DBaaSRestore struct {
 	Id           string           `json:"id,omitempty"`
 	BackupSource string           `json:"backup_source,omitempty"`
+	PointInTime  uint64           `json:"point_in_time,omitempty"`
+	InPlace      bool             `json:"in_place"`
 	CreatedAt    uint64           `json:"created_at,omitempty"`
 	Status       string           `json:"status,omitempty"`
 	NewService   *DBaaSCreateInfo `json:"new_service,omitempty"`
+
+	// Target holds the name, pack and tags of the service created when not restoring in place
+	Target *DBaaS `json:"target,omitempty"`
+}
+
+type DBaaSDatabase struct {
+	Name         string `json:"name"`
+	CharacterSet string `json:"character_set,omitempty"`
+	Collation    string `json:"collation,omitempty"`
+}
+
+type DBaaSUser struct {
+	Name     string `json:"name,omitempty"`
+	Password string `json:"password,omitempty"`
+	// Grants are always sent, an empty list revokes every privilege
+	Grants []*DBaaSGrant `json:"grants"`
+}
+
+type DBaaSGrant struct {
+	Database   string   `json:"database"`
+	Privileges []string `json:"privileges"`
 }
 
 func (dbaas *DBaaS) Key() string {
@@ -4,9 +4,12 @@ type Api interface {
 	FindPack(dbType string, name string) (*DBaaSPack, error)
 
 	GetDBaaS(publicCloudId int64, publicCloudProjectId int64, DBaaSId int64) (*DBaaS, error)
+	ListDBaaS(publicCloudId int64, publicCloudProjectId int64, filter DBaaSFilter) ([]*DBaaS, error)
 	CreateDBaaS(input *DBaaS) (*DBaaSCreateInfo, error)
 	UpdateDBaaS(input *DBaaS) (bool, error)
 	DeleteDBaaS(publicCloudId int64, publicCloudProjectId int64, DBaaSId int64) (bool, error)
+	ResetPassword(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, password string) (bool, error)
+	UpgradeDBaaS(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, version string) (bool, error)
 
 	GetConfiguration(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) (map[string]any, error)
 	PutConfiguration(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, configuration map[string]any) (bool, error)
@@ -19,7 +22,26 @@ type Api interface {
 	UpdateDBaasScheduleBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, id int64, backupSchedules *DBaasBackupSchedule) (bool, error)
 	DeleteDBaasScheduleBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, id int64) (bool, error)
 
+	CreateBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) (string, error)
+	GetBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, backupId string) (*DBaaSBackup, error)
+	ListBackups(publicCloudId int64, publicCloudProjectId int64, dbaasId int64) ([]*DBaaSBackup, error)
+	DeleteBackup(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, backupId string) (bool, error)
+
+	CreateRestore(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *DBaaSRestore) (*DBaaSRestore, error)
+	GetRestore(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, restoreId string) (*DBaaSRestore, error)
+
+	CreateDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *DBaaSDatabase) (bool, error)
+	GetDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (*DBaaSDatabase, error)
+	DeleteDatabase(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (bool, error)
+
+	CreateUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, input *DBaaSUser) (bool, error)
+	GetUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (*DBaaSUser, error)
+	UpdateUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string, input *DBaaSUser) (bool, error)
+	DeleteUser(publicCloudId int64, publicCloudProjectId int64, dbaasId int64, name string) (bool, error)
+
 	GetDbaasRegions() ([]string, error)
 	GetDbaasTypes() ([]*DbaasType, error)
+	GetConfigurationSchema(dbType string, version string) ([]*DBaaSConfigurationParameter, error)
 	GetDbaasPack(params PackFilter) (*Pack, error)
+	ListDbaasPacks(params PackFilter) ([]*Pack, error)
 }
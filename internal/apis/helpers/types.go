@@ -1,10 +1,14 @@
 package helpers
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
 
+// ErrNotFound is returned when the requested object does not exist (anymore)
+var ErrNotFound = errors.New("not found")
+
 type NormalizedApiResponse[K any] struct {
 	Result string    `json:"result"`
 	Data   K         `json:"data"`
@@ -42,3 +46,11 @@ func (apiError *ApiError) Error() string {
 
 	return strings.TrimSuffix(builder.String(), "\n")
 }
+
+// NotFoundError wraps the error returned by the API for a missing object so it matches ErrNotFound
+func NotFoundError(apiError *ApiError) error {
+	if apiError == nil {
+		return ErrNotFound
+	}
+	return fmt.Errorf("%w: %w", ErrNotFound, apiError)
+}
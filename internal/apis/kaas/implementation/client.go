@@ -2,6 +2,7 @@ package implementation
 
 import (
 	"fmt"
+	"net/http"
 	"net/netip"
 	"terraform-provider-infomaniak/internal/apis/helpers"
 	"terraform-provider-infomaniak/internal/apis/kaas"
@@ -63,6 +64,27 @@ func (client *Client) GetVersions() ([]string, error) {
 	return result.Data, nil
 }
 
+func (client *Client) GetKaases(publicCloudId int64, publicCloudProjectId int64) ([]*kaas.Kaas, error) {
+	var result helpers.NormalizedApiResponse[[]*kaas.Kaas]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetQueryParam("with", "packs,projects,tags").
+		SetResult(&result).
+		SetError(&result).
+		Get(EndpointKaases)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
 func (client *Client) GetKaas(publicCloudId int64, publicCloudProjectId int64, kaasId int64) (*kaas.Kaas, error) {
 	var result helpers.NormalizedApiResponse[*kaas.Kaas]
 
@@ -70,7 +92,7 @@ func (client *Client) GetKaas(publicCloudId int64, publicCloudProjectId int64, k
 		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
 		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
 		SetPathParam("kaas_id", fmt.Sprint(kaasId)).
-		SetQueryParam("with", "packs,projects,instances,tags").
+		SetQueryParam("with", "packs,projects,instances,tags,network").
 		SetResult(&result).
 		SetError(&result).
 		Get(EndpointKaas)
@@ -78,6 +100,10 @@ func (client *Client) GetKaas(publicCloudId int64, publicCloudProjectId int64, k
 		return nil, err
 	}
 
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, helpers.NotFoundError(result.Error)
+	}
+
 	if resp.IsError() {
 		return nil, result.Error
 	}
@@ -106,6 +132,27 @@ func (client *Client) GetKubeconfig(publicCloudId int64, publicCloudProjectId in
 	return result.Data, nil
 }
 
+func (client *Client) RotateKubeconfig(publicCloudId int64, publicCloudProjectId int64, kaasId int64) (bool, error) {
+	var result helpers.NormalizedApiResponse[bool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("kaas_id", fmt.Sprint(kaasId)).
+		SetResult(&result).
+		SetError(&result).
+		Post(EndpointKaasKubeconfigRotation)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.IsError() {
+		return false, result.Error
+	}
+
+	return result.Data, nil
+}
+
 func (client *Client) CreateKaas(input *kaas.Kaas) (int64, error) {
 	var result helpers.NormalizedApiResponse[int64]
 
@@ -170,6 +217,34 @@ func (client *Client) DeleteKaas(publicCloudId int64, publicCloudProjectId int64
 	return result.Data, nil
 }
 
+func (client *Client) GetInstancePools(publicCloudId int64, publicCloudProjectId int64, kaasId int64) ([]*kaas.InstancePool, error) {
+	var result helpers.NormalizedApiResponse[[]*kaas.InstancePool]
+
+	resp, err := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("kaas_id", fmt.Sprint(kaasId)).
+		SetResult(&result).
+		SetError(&result).
+		Get(EndpointInstancePools)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	// Default Max = Min
+	for _, instancePool := range result.Data {
+		if instancePool.MaxInstances == 0 {
+			instancePool.MaxInstances = instancePool.MinInstances
+		}
+	}
+
+	return result.Data, nil
+}
+
 func (client *Client) GetInstancePool(publicCloudId int64, publicCloudProjectId int64, kaasId int64, instancePoolId int64) (*kaas.InstancePool, error) {
 	var result helpers.NormalizedApiResponse[*kaas.InstancePool]
 
@@ -185,6 +260,10 @@ func (client *Client) GetInstancePool(publicCloudId int64, publicCloudProjectId
 		return nil, err
 	}
 
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, helpers.NotFoundError(result.Error)
+	}
+
 	if resp.IsError() {
 		return nil, result.Error
 	}
@@ -264,6 +343,32 @@ func (client *Client) DeleteInstancePool(publicCloudId int64, publicCloudProject
 	return result.Data, nil
 }
 
+func (client *Client) GetNodes(publicCloudId int64, publicCloudProjectId int64, kaasId int64, instancePoolId *int64) ([]*kaas.Node, error) {
+	var result helpers.NormalizedApiResponse[[]*kaas.Node]
+
+	builder := client.resty.R().
+		SetPathParam("public_cloud_id", fmt.Sprint(publicCloudId)).
+		SetPathParam("public_cloud_project_id", fmt.Sprint(publicCloudProjectId)).
+		SetPathParam("kaas_id", fmt.Sprint(kaasId)).
+		SetResult(&result).
+		SetError(&result)
+
+	if instancePoolId != nil {
+		builder = builder.SetQueryParam("filter[instance_pool_id]", fmt.Sprint(*instancePoolId))
+	}
+
+	resp, err := builder.Get(EndpointNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsError() {
+		return nil, result.Error
+	}
+
+	return result.Data, nil
+}
+
 func (client *Client) PatchApiserverParams(input *kaas.Apiserver, publicCloudId int64, projectId int64, kaasId int64) (bool, error) {
 	var result helpers.NormalizedApiResponse[bool]
 	resp, err := client.resty.R().
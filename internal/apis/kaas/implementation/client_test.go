@@ -18,8 +18,11 @@ const (
 	TestEndpointKaases         = `=~^/1/public_clouds/\d+/projects/\d+/kaas\z`
 	TestEndpointKaas           = `=~^/1/public_clouds/\d+/projects/\d+/kaas/\d+\z`
 	TestEndpointKaasKubeconfig = `=~^/1/public_clouds/\d+/projects/\d+/kaas/\d+/kube_config\z`
+	TestEndpointKaasRotation   = `=~^/1/public_clouds/\d+/projects/\d+/kaas/\d+/kube_config/rotate\z`
 	TestEndpointInstancePools  = `=~^/1/public_clouds/\d+/projects/\d+/kaas/\d+/instance_pools\z`
 	TestEndpointInstancePool   = `=~^/1/public_clouds/\d+/projects/\d+/kaas/\d+/instance_pools/\d+\z`
+	TestEndpointNodes          = `=~^/1/public_clouds/\d+/projects/\d+/kaas/\d+/nodes\z`
+	TestEndpointApiserver      = `=~^/1/public_clouds/\d+/projects/\d+/kaas/\d+/apiserver\z`
 )
 
 func NewSuccessResponse[K any](data K) helpers.NormalizedApiResponse[K] {
@@ -74,6 +77,20 @@ var _ = Describe("KaaS API Client", func() {
 			Expect(kaas.Id).To(Equal(expectedResult.Id))
 		})
 
+		It("should report a missing KaaS as not found", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", TestEndpointKaas, httpmock.NewJsonResponderOrPanic(404, helpers.NormalizedApiResponse[any]{
+				Result: "error",
+				Error:  &helpers.ApiError{Description: "Object not found"},
+			}))
+
+			_, err := client.GetKaas(1, 1, 12)
+			Expect(err).To(MatchError(helpers.ErrNotFound))
+			Expect(err.Error()).To(ContainSubstring("Object not found"))
+		})
+
 		It("should be able to create KaaS", func() {
 			httpmock.ActivateNonDefault(client.resty.Client())
 			defer httpmock.DeactivateAndReset()
@@ -112,6 +129,44 @@ var _ = Describe("KaaS API Client", func() {
 			Expect(err).Should(HaveOccurred())
 		})
 
+		It("should be able to rotate the KaaS kubeconfig", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("POST", TestEndpointKaasRotation, httpmock.NewJsonResponderOrPanic(200, NewSuccessResponse(true)))
+
+			rotated, err := client.RotateKubeconfig(1, 1, 12)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rotated).To(BeTrue())
+			Expect(httpmock.GetCallCountInfo()["POST "+TestEndpointKaasRotation]).To(Equal(1))
+		})
+
+		It("should split the Apiserver params it does not abstract", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", TestEndpointApiserver, httpmock.NewStringResponder(200, `{
+				"result": "success",
+				"data": {
+					"apiserver_params": {
+						"--oidc-client-id": "kubernetes",
+						"--profiling": "false",
+						"--max-requests-inflight": 800
+					},
+					"oidc_ca": null
+				}
+			}`).HeaderSet(http.Header{"Content-Type": []string{"application/json"}}))
+
+			apiserver, err := client.GetApiserverParams(1, 1, 12)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(apiserver.Params.ClientId).To(HaveValue(Equal("kubernetes")))
+			Expect(apiserver.NonSpecificApiServerParams).To(Equal(map[string]string{
+				"--profiling":             "false",
+				"--max-requests-inflight": "800",
+			}))
+		})
+
 		It("should be able to get KaaS Instance Pool", func() {
 			httpmock.ActivateNonDefault(client.resty.Client())
 			defer httpmock.DeactivateAndReset()
@@ -127,5 +182,45 @@ var _ = Describe("KaaS API Client", func() {
 
 			Expect(instancePool.Id).To(Equal(expectedResult.Id))
 		})
+
+		It("should report a missing KaaS Instance Pool as not found", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("GET", TestEndpointInstancePool, httpmock.NewJsonResponderOrPanic(404, helpers.NormalizedApiResponse[any]{
+				Result: "error",
+				Error:  &helpers.ApiError{Description: "Object not found"},
+			}))
+
+			_, err := client.GetInstancePool(1, 1, 12, 12)
+			Expect(err).To(MatchError(helpers.ErrNotFound))
+		})
+
+		It("should filter KaaS nodes by instance pool", func() {
+			httpmock.ActivateNonDefault(client.resty.Client())
+			defer httpmock.DeactivateAndReset()
+
+			expectedResult := []*kaas.Node{
+				{
+					Name:           "pool-0",
+					InstancePoolId: 12,
+				},
+			}
+
+			httpmock.RegisterResponder("GET", TestEndpointNodes, func(req *http.Request) (*http.Response, error) {
+				if req.URL.Query().Get("filter[instance_pool_id]") != "12" {
+					return httpmock.NewBytesResponse(400, []byte("missing filter")), nil
+				}
+
+				return httpmock.NewJsonResponse(200, NewSuccessResponse(expectedResult))
+			})
+
+			instancePoolId := int64(12)
+			nodes, err := client.GetNodes(1, 1, 12, &instancePoolId)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(nodes).To(HaveLen(1))
+			Expect(nodes[0].Name).To(Equal("pool-0"))
+		})
 	})
 })
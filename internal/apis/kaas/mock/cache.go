@@ -5,8 +5,12 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"maps"
 	"os"
 	"path"
+	"slices"
+	"terraform-provider-infomaniak/internal/apis/helpers"
 	"terraform-provider-infomaniak/internal/apis/kaas"
 	"time"
 )
@@ -20,7 +24,7 @@ var (
 	mockedApiStatePath = path.Join(os.TempDir(), "terraform-provider-infomaniak-kaas")
 	mockedApiState     = make(map[string][]byte)
 
-	ErrKeyNotFound  = errors.New("key not found")
+	ErrKeyNotFound  = fmt.Errorf("key %w", helpers.ErrNotFound)
 	ErrDuplicateKey = errors.New("duplicate key found")
 )
 
@@ -44,6 +48,23 @@ func getFromCache[K KaasObject](key string) (K, error) {
 	return result, nil
 }
 
+func listFromCache[K KaasObject](match func(key string) bool) ([]K, error) {
+	var results []K
+	for _, key := range slices.Sorted(maps.Keys(mockedApiState)) {
+		if !match(key) {
+			continue
+		}
+
+		result, err := getFromCache[K](key)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 func addToCache[K KaasObject](obj K) error {
 	key := obj.Key()
 	_, found := mockedApiState[key]
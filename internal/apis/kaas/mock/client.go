@@ -5,7 +5,10 @@ import (
 	"log"
 	"net/netip"
 	"regexp"
+	"slices"
+	"strings"
 	"terraform-provider-infomaniak/internal/apis/kaas"
+	"time"
 )
 
 // Ensure that our client implements Api
@@ -13,6 +16,8 @@ var (
 	_               kaas.Api = (*Client)(nil)
 	dnsRegexp                = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
 	kubeLabelRegexp          = regexp.MustCompile(`^[a-zA-Z0-9\-./]+:\s*[a-zA-Z0-9\-_.]{1,63}$`)
+
+	mockedIpFilters = make(map[string][]netip.Prefix)
 )
 
 type Client struct{}
@@ -51,6 +56,22 @@ func (c *Client) GetVersions() ([]string, error) {
 	return []string{"1.29", "1.30", "1.31"}, nil
 }
 
+func (c *Client) GetKaases(publicCloudId int64, publicCloudProjectId int64) ([]*kaas.Kaas, error) {
+	prefix := fmt.Sprintf("%d-%d-", publicCloudId, publicCloudProjectId)
+	kaases, err := listFromCache[*kaas.Kaas](func(key string) bool {
+		return strings.HasPrefix(key, prefix) && strings.Count(key, "-") == 2
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kaasObject := range kaases {
+		kaasObject.Status = "Active"
+	}
+
+	return kaases, nil
+}
+
 func (c *Client) GetKaas(publicCloudId int64, publicCloudProjectId int64, kaasId int64) (*kaas.Kaas, error) {
 	key := fmt.Sprintf("%d-%d-%d", publicCloudId, publicCloudProjectId, kaasId)
 	obj, err := getFromCache[*kaas.Kaas](key)
@@ -67,6 +88,15 @@ func (client *Client) GetKubeconfig(publicCloudId int64, publicCloudProjectId in
 	return genKubeconfig(), nil
 }
 
+func (c *Client) RotateKubeconfig(publicCloudId int64, publicCloudProjectId int64, kaasId int64) (bool, error) {
+	if _, err := c.GetKaas(publicCloudId, publicCloudProjectId, kaasId); err != nil {
+		return false, err
+	}
+
+	// GetKubeconfig already hands out fresh credentials on every call
+	return true, nil
+}
+
 func (c *Client) CreateKaas(input *kaas.Kaas) (int64, error) {
 	// Checks
 	if input.Project.PublicCloudId == 0 {
@@ -86,8 +116,23 @@ func (c *Client) CreateKaas(input *kaas.Kaas) (int64, error) {
 		PackId:            input.PackId,
 		Pack:              c.MustGetPackFromId(input.PackId),
 		Name:              input.Name,
+		Tags:              input.Tags,
+		Network:           input.Network,
+		MaintenanceWindow: input.MaintenanceWindow,
+		AutoUpgrade:       input.AutoUpgrade,
+		CreatedAt:         uint64(time.Now().Unix()),
+	}
+	if obj.Network != nil {
+		if obj.Network.PodCidr == "" {
+			obj.Network.PodCidr = "10.244.0.0/16"
+		}
+		if obj.Network.ServiceCidr == "" {
+			obj.Network.ServiceCidr = "10.96.0.0/12"
+		}
 	}
 	obj.Id = genId()
+	obj.UpdatedAt = obj.CreatedAt
+	obj.ApiserverEndpoint = fmt.Sprintf("https://%d.kaas.mock.infomaniak.cloud:6443", obj.Id)
 
 	return obj.Id, addToCache(&obj)
 }
@@ -116,6 +161,29 @@ func (c *Client) UpdateKaas(input *kaas.Kaas) (bool, error) {
 		PackId:            input.PackId,
 		Pack:              c.MustGetPackFromId(input.PackId),
 		KubernetesVersion: input.KubernetesVersion,
+		Tags:              input.Tags,
+		MaintenanceWindow: input.MaintenanceWindow,
+		AutoUpgrade:       input.AutoUpgrade,
+		UpdatedAt:         uint64(time.Now().Unix()),
+	}
+
+	if existing, err := getFromCache[*kaas.Kaas](obj.Key()); err == nil {
+		obj.CreatedAt = existing.CreatedAt
+		obj.ApiserverEndpoint = existing.ApiserverEndpoint
+		obj.Network = existing.Network
+		if obj.Tags == nil {
+			obj.Tags = existing.Tags
+		}
+		// Omitted fields are left untouched by the API
+		if obj.KubernetesVersion == "" {
+			obj.KubernetesVersion = existing.KubernetesVersion
+		}
+		if obj.MaintenanceWindow == nil {
+			obj.MaintenanceWindow = existing.MaintenanceWindow
+		}
+		if obj.AutoUpgrade == "" {
+			obj.AutoUpgrade = existing.AutoUpgrade
+		}
 	}
 
 	return true, updateCache(&obj)
@@ -133,6 +201,27 @@ func (c *Client) DeleteKaas(publicCloudId int64, publicCloudProjectId int64, kaa
 	return true, removeFromCache(&obj)
 }
 
+func (c *Client) GetInstancePools(publicCloudId int64, publicCloudProjectId int64, kaasId int64) ([]*kaas.InstancePool, error) {
+	_, err := c.GetKaas(publicCloudId, publicCloudProjectId, kaasId)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("%d-", kaasId)
+	instancePools, err := listFromCache[*kaas.InstancePool](func(key string) bool {
+		return strings.HasPrefix(key, prefix) && strings.Count(key, "-") == 1
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instancePool := range instancePools {
+		instancePool.Status = "Active"
+	}
+
+	return instancePools, nil
+}
+
 func (c *Client) GetInstancePool(publicCloudId int64, publicCloudProjectId int64, kaasId int64, instancePoolId int64) (*kaas.InstancePool, error) {
 	_, err := c.GetKaas(publicCloudId, publicCloudProjectId, kaasId)
 	if err != nil {
@@ -277,6 +366,39 @@ func (c *Client) DeleteInstancePool(publicCloudId int64, publicCloudProjectId in
 	return true, removeFromCache(&obj)
 }
 
+func (c *Client) GetNodes(publicCloudId int64, publicCloudProjectId int64, kaasId int64, instancePoolId *int64) ([]*kaas.Node, error) {
+	kaasObject, err := c.GetKaas(publicCloudId, publicCloudProjectId, kaasId)
+	if err != nil {
+		return nil, err
+	}
+
+	instancePools, err := c.GetInstancePools(publicCloudId, publicCloudProjectId, kaasId)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*kaas.Node
+	for _, instancePool := range instancePools {
+		if instancePoolId != nil && instancePool.Id != *instancePoolId {
+			continue
+		}
+
+		for i := range instancePool.AvailableInstances {
+			nodes = append(nodes, &kaas.Node{
+				Name:              fmt.Sprintf("%s-%d", instancePool.Name, i),
+				InstancePoolId:    instancePool.Id,
+				Status:            "Ready",
+				FlavorName:        instancePool.FlavorName,
+				AvailabilityZone:  instancePool.AvailabilityZone,
+				PrivateIp:         fmt.Sprintf("10.0.0.%d", i+1),
+				KubernetesVersion: kaasObject.KubernetesVersion,
+			})
+		}
+	}
+
+	return nodes, nil
+}
+
 func (c *Client) GetApiserverParams(publicCloudId int64, projectId int64, kaasId int64) (*kaas.Apiserver, error) {
 	return nil, nil
 }
@@ -284,9 +406,12 @@ func (c *Client) PatchApiserverParams(input *kaas.Apiserver, publicCloudId int64
 	return true, nil
 }
 func (client *Client) PutIPFilters(cidrs []netip.Prefix, publicCloudId int64, projectId int64, kaasId int64) (bool, error) {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, projectId, kaasId)
+	mockedIpFilters[key] = slices.Clone(cidrs)
 	return true, nil
 }
 
 func (client *Client) GetIPFilters(publicCloudId int64, projectId int64, kaasId int64) ([]netip.Prefix, error) {
-	return nil, nil
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, projectId, kaasId)
+	return slices.Clone(mockedIpFilters[key]), nil
 }
@@ -44,6 +44,67 @@ func (a *Apiserver) MarshalJSON() ([]byte, error) {
 	return result, err
 }
 
+var _ json.Unmarshaler = (*Apiserver)(nil)
+
+// UnmarshalJSON splits apiserver_params between the flags abstracted by ApiServerParams and the other ones
+func (a *Apiserver) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Params          map[string]json.RawMessage `json:"apiserver_params"`
+		OidcCa          *string                    `json:"oidc_ca"`
+		AuditLogWebhook *string                    `json:"audit-webhook-config"`
+		AuditLogPolicy  *string                    `json:"audit-policy"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	a.OidcCa = raw.OidcCa
+	a.AuditLogWebhook = raw.AuditLogWebhook
+	a.AuditLogPolicy = raw.AuditLogPolicy
+	a.Params = nil
+	a.NonSpecificApiServerParams = nil
+	if raw.Params == nil {
+		return nil
+	}
+
+	// Flags are strings, anything else is kept as its JSON text
+	params := make(map[string]string, len(raw.Params))
+	for key, value := range raw.Params {
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			text = string(value)
+		}
+		params[key] = text
+	}
+
+	paramBytes, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	a.Params = &ApiServerParams{}
+	if err := json.Unmarshal(paramBytes, a.Params); err != nil {
+		return err
+	}
+
+	knownBytes, err := json.Marshal(a.Params)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]string)
+	if err := json.Unmarshal(knownBytes, &known); err != nil {
+		return err
+	}
+
+	a.NonSpecificApiServerParams = make(map[string]string)
+	for key, value := range params {
+		if _, ok := known[key]; !ok {
+			a.NonSpecificApiServerParams[key] = value
+		}
+	}
+
+	return nil
+}
+
 type ApiServerParams struct {
 	IssuerUrl      *string `json:"--oidc-issuer-url,omitempty"`
 	ClientId       *string `json:"--oidc-client-id,omitempty"`
@@ -65,12 +126,37 @@ type Kaas struct {
 	Region            string `json:"region,omitempty"`
 	KubernetesVersion string `json:"kubernetes_version,omitempty"`
 	Status            string `json:"status,omitempty"`
+	ApiserverEndpoint string `json:"apiserver_endpoint,omitempty"`
+	CreatedAt         uint64 `json:"created_at,omitempty"`
+	UpdatedAt         uint64 `json:"updated_at,omitempty"`
+
+	Network           *KaasNetwork       `json:"network,omitempty"`
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
+	AutoUpgrade       string             `json:"auto_upgrade,omitempty"`
+
+	Tags map[string]string `json:"tags,omitzero"`
 }
 
 func (kaas *Kaas) Key() string {
 	return fmt.Sprintf("%d-%d-%d", kaas.Project.PublicCloudId, kaas.Project.ProjectId, kaas.Id)
 }
 
+// KaasNetwork is only taken into account when the cluster is created
+type KaasNetwork struct {
+	PodCidr     string  `json:"pod_cidr,omitempty"`
+	ServiceCidr string  `json:"service_cidr,omitempty"`
+	NetworkId   *string `json:"network_id,omitempty"`
+	SubnetId    *string `json:"subnet_id,omitempty"`
+}
+
+// MaintenanceWindow is the weekly slot during which the platform may upgrade the control plane
+type MaintenanceWindow struct {
+	Day       string `json:"day"`
+	StartTime string `json:"start_time"`
+	Duration  int64  `json:"duration"`
+	Timezone  string `json:"timezone"`
+}
+
 type KaasProject struct {
 	PublicCloudId int64 `json:"public_cloud_id,omitempty"`
 	ProjectId     int64 `json:"id,omitempty"`
@@ -97,3 +183,15 @@ type InstancePool struct {
 func (instancePool *InstancePool) Key() string {
 	return fmt.Sprintf("%d-%d", instancePool.KaasId, instancePool.Id)
 }
+
+type Node struct {
+	Name           string `json:"name,omitempty"`
+	InstancePoolId int64  `json:"instance_pool_id,omitempty"`
+
+	Status            string `json:"status,omitempty"`
+	FlavorName        string `json:"flavor,omitempty"`
+	AvailabilityZone  string `json:"availability_zone,omitempty"`
+	PrivateIp         string `json:"private_ip,omitempty"`
+	PublicIp          string `json:"public_ip,omitempty"`
+	KubernetesVersion string `json:"kubernetes_version,omitempty"`
+}
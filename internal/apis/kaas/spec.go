@@ -6,18 +6,23 @@ type Api interface {
 	GetPacks() ([]*KaasPack, error)
 	GetVersions() ([]string, error)
 
+	GetKaases(publicCloudId int64, publicCloudProjectId int64) ([]*Kaas, error)
 	GetKaas(publicCloudId int64, publicCloudProjectId int64, kaasId int64) (*Kaas, error)
 	CreateKaas(input *Kaas) (int64, error)
 	UpdateKaas(input *Kaas) (bool, error)
 	DeleteKaas(publicCloudId int64, publicCloudProjectId int64, kaasId int64) (bool, error)
 
 	GetKubeconfig(publicCloudId int64, publicCloudProjectId int64, kaasId int64) (string, error)
+	RotateKubeconfig(publicCloudId int64, publicCloudProjectId int64, kaasId int64) (bool, error)
 
+	GetInstancePools(publicCloudId int64, publicCloudProjectId int64, kaasId int64) ([]*InstancePool, error)
 	GetInstancePool(publicCloudId int64, publicCloudProjectId int64, kaasId int64, instancePoolId int64) (*InstancePool, error)
 	CreateInstancePool(publicCloudId int64, publicCloudProjectId int64, input *InstancePool) (int64, error)
 	UpdateInstancePool(publicCloudId int64, publicCloudProjectId int64, input *InstancePool) (bool, error)
 	DeleteInstancePool(publicCloudId int64, publicCloudProjectId int64, kaasId int64, instancePoolId int64) (bool, error)
 
+	GetNodes(publicCloudId int64, publicCloudProjectId int64, kaasId int64, instancePoolId *int64) ([]*Node, error)
+
 	GetApiserverParams(publicCloudId int64, projectId int64, kaasId int64) (*Apiserver, error)
 	PatchApiserverParams(input *Apiserver, publicCloudId int64, projectId int64, kaasId int64) (bool, error)
 	PutIPFilters(cidrs []netip.Prefix, publicCloudId, projectId, kaasId int64) (bool, error)
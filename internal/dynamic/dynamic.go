@@ -1,6 +1,7 @@
 package dynamic
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -63,7 +64,10 @@ func attrValueToJSON(val attr.Value, handler UnknownValueHandler) ([]byte, error
 	case types.Float64:
 		return json.Marshal(value.ValueFloat64())
 	case types.Number:
-		v, _ := value.ValueBigFloat().Float64()
+		v, err := numberToJSON(value.ValueBigFloat())
+		if err != nil {
+			return nil, err
+		}
 		return json.Marshal(v)
 	case types.List:
 		l, err := attrListToJSON(value.Elements(), handler)
@@ -148,11 +152,15 @@ func attrValueFromJSON(b []byte, typ attr.Type) (attr.Value, error) {
 		if b == nil || string(b) == "null" {
 			return types.Int64Null(), nil
 		}
-		var v int64
+		var v json.Number
 		if err := json.Unmarshal(b, &v); err != nil {
 			return nil, err
 		}
-		return types.Int64Value(v), nil
+		i, err := v.Int64()
+		if err != nil {
+			return nil, err
+		}
+		return types.Int64Value(i), nil
 	case basetypes.Float64Type:
 		if b == nil || string(b) == "null" {
 			return types.Float64Null(), nil
@@ -166,11 +174,15 @@ func attrValueFromJSON(b []byte, typ attr.Type) (attr.Value, error) {
 		if b == nil || string(b) == "null" {
 			return types.NumberNull(), nil
 		}
-		var v float64
+		var v json.Number
 		if err := json.Unmarshal(b, &v); err != nil {
 			return nil, err
 		}
-		return types.NumberValue(big.NewFloat(v)), nil
+		f, err := numberFromJSON(v)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(f), nil
 	case basetypes.ListType:
 		if b == nil || string(b) == "null" {
 			return types.ListNull(typ.ElemType), nil
@@ -284,7 +296,7 @@ func attrValueFromJSON(b []byte, typ attr.Type) (attr.Value, error) {
 // FromJSONImplied is similar to FromJSON, while it is for typeless case.
 // In which case, the following type conversion rules are applied (Go -> TF):
 // - bool: bool
-// - float64: number
+// - json.Number: number, without losing precision
 // - string: string
 // - []interface{}: tuple
 // - map[string]interface{}: object
@@ -343,17 +355,23 @@ func attrValueFromJSONImplied(b []byte) (attr.Type, attr.Value, error) {
 		return typ, val, nil
 	}
 
-	// Primitives
+	// Primitives, numbers are kept as written to not lose precision
 	var v interface{}
-	if err := json.Unmarshal(b, &v); err != nil {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
 		return nil, nil, fmt.Errorf("failed to unmarshal %s: %v", string(b), err)
 	}
 
 	switch v := v.(type) {
 	case bool:
 		return types.BoolType, types.BoolValue(v), nil
-	case float64:
-		return types.NumberType, types.NumberValue(big.NewFloat(v)), nil
+	case json.Number:
+		f, err := numberFromJSON(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		return types.NumberType, types.NumberValue(f), nil
 	case string:
 		if v == "<unknown>" {
 			return types.DynamicType, types.DynamicUnknown(), nil
@@ -366,6 +384,35 @@ func attrValueFromJSONImplied(b []byte) (attr.Type, attr.Value, error) {
 	}
 }
 
+// numberPrecision is the precision terraform uses for its numbers
+const numberPrecision = 512
+
+// numberFromJSON parses a JSON number without going through float64, integers are kept exact
+func numberFromJSON(n json.Number) (*big.Float, error) {
+	if i, ok := new(big.Int).SetString(n.String(), 10); ok {
+		prec := max(uint(i.BitLen()), numberPrecision)
+		return new(big.Float).SetPrec(prec).SetInt(i), nil
+	}
+
+	f, _, err := big.ParseFloat(n.String(), 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse number %s: %v", n, err)
+	}
+	return f, nil
+}
+
+// numberToJSON writes a number without going through float64, integers are written without exponent
+func numberToJSON(f *big.Float) (json.Number, error) {
+	if f.IsInf() {
+		return "", fmt.Errorf("infinite number cannot be written as JSON")
+	}
+	if f.IsInt() {
+		i, _ := f.Int(nil)
+		return json.Number(i.String()), nil
+	}
+	return json.Number(f.Text('g', -1)), nil
+}
+
 // IsFullyKnown returns true if `val` is known. If `val` is an aggregate type,
 // IsFullyKnown only returns true if all elements and attributes are known, as
 // well.
@@ -0,0 +1,76 @@
+package dynamic
+
+import (
+	"encoding/json"
+	"math/big"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("numberFromJSON", func() {
+	DescribeTable("parsing numbers",
+		func(in string, expected string) {
+			f, err := numberFromJSON(json.Number(in))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Text('f', -1)).To(Equal(expected))
+		},
+		Entry("small integer", "42", "42"),
+		Entry("negative integer", "-42", "-42"),
+		Entry("integer above 2^53", "9007199254740993", "9007199254740993"),
+		Entry("integer above 2^64", "123456789012345678901234567890", "123456789012345678901234567890"),
+		Entry("decimal", "0.1", "0.1"),
+		Entry("exponent", "1e3", "1000"),
+		Entry("negative exponent", "1.5e-3", "0.0015"),
+	)
+
+	It("keeps integers above 2^53 exact", func() {
+		f, err := numberFromJSON(json.Number("9007199254740993"))
+		Expect(err).NotTo(HaveOccurred())
+
+		i, accuracy := f.Int(nil)
+		Expect(accuracy).To(Equal(big.Exact))
+		Expect(i.String()).To(Equal("9007199254740993"))
+	})
+
+	It("rejects what is not a number", func() {
+		_, err := numberFromJSON(json.Number("a lot"))
+		Expect(err).To(MatchError(ContainSubstring("failed to parse number a lot")))
+	})
+})
+
+var _ = Describe("numberToJSON", func() {
+	DescribeTable("writing numbers",
+		func(in string, expected string) {
+			f, err := numberFromJSON(json.Number(in))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(numberToJSON(f)).To(Equal(json.Number(expected)))
+		},
+		Entry("small integer", "42", "42"),
+		Entry("integer above 2^53", "9007199254740993", "9007199254740993"),
+		Entry("integer above 2^64", "123456789012345678901234567890", "123456789012345678901234567890"),
+		Entry("decimal", "0.1", "0.1"),
+		Entry("integer exponent", "1e3", "1000"),
+		Entry("decimal exponent", "1.5e-3", "0.0015"),
+		Entry("large decimal exponent", "1.5e-30", "1.5e-30"),
+	)
+
+	It("rejects infinite numbers", func() {
+		_, err := numberToJSON(new(big.Float).SetInf(false))
+		Expect(err).To(MatchError(ContainSubstring("infinite number")))
+	})
+})
+
+var _ = Describe("FromJSONImplied", func() {
+	DescribeTable("round-tripping to the same JSON",
+		func(in string) {
+			d, err := FromJSONImplied([]byte(in))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ToJSON(d)).To(MatchJSON(in))
+			Expect(ToJSON(d)).To(Equal([]byte(in)))
+		},
+		Entry("integer above 2^53", `9007199254740993`),
+		Entry("decimal", `0.1`),
+		Entry("object", `{"big":9007199254740993,"decimal":0.1,"list":[1,2.5,"a"],"null":null}`),
+	)
+})
@@ -48,8 +48,9 @@ type IkProviderData struct {
 }
 
 type IkProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host        types.String `tfsdk:"host"`
+	Token       types.String `tfsdk:"token"`
+	DefaultTags types.Map    `tfsdk:"default_tags"`
 }
 
 func (p *IkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -72,6 +73,12 @@ func (p *IkProvider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description:         "The token used for authenticating against Infomaniak's API.",
 				MarkdownDescription: "The token used for authenticating against Infomaniak's API.",
 			},
+			"default_tags": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Tags applied to every taggable resource, tags set on a resource take precedence.",
+				MarkdownDescription: "Tags applied to every taggable resource, tags set on a resource take precedence.",
+			},
 		},
 		Description:         "Infomaniak's provider.",
 		MarkdownDescription: "Infomaniak's provider.",
@@ -114,6 +121,15 @@ func (p *IkProvider) Configure(ctx context.Context, req provider.ConfigureReques
 		)
 	}
 
+	if data.DefaultTags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unknown Infomaniak default tags",
+			"The provider cannot compute the tags of the resources as there is an unknown configuration value for the default tags. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"terraform-provider-infomaniak/internal/apis"
@@ -21,3 +22,23 @@ func GetApiClient(providerData any) (*apis.Client, error) {
 
 	return client, nil
 }
+
+// GetDefaultTags returns the default_tags set on the provider, merged into the tags of every taggable resource
+func GetDefaultTags(ctx context.Context, providerData any) (map[string]string, error) {
+	data, ok := providerData.(*IkProviderData)
+	if !ok {
+		return nil, fmt.Errorf("expected *provider.IkProviderData, got: %T", providerData)
+	}
+
+	defaultTags := make(map[string]string)
+	if data.Data.DefaultTags.IsNull() {
+		return defaultTags, nil
+	}
+
+	diags := data.Data.DefaultTags.ElementsAs(ctx, &defaultTags, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not read default tags: %v", diags.Errors())
+	}
+
+	return defaultTags, nil
+}
@@ -0,0 +1,251 @@
+package dbaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/apis/helpers"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &dbaasBackupResource{}
+	_ resource.ResourceWithConfigure   = &dbaasBackupResource{}
+	_ resource.ResourceWithImportState = &dbaasBackupResource{}
+)
+
+// backupTimeout bounds how long Create waits for a backup to be completed
+const backupTimeout = 2 * time.Hour
+
+func NewDBaasBackupResource() resource.Resource {
+	return &dbaasBackupResource{}
+}
+
+type dbaasBackupResource struct {
+	client *apis.Client
+}
+
+type DBaasBackupModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+	DbaasId              types.Int64 `tfsdk:"dbaas_id"`
+
+	Id          types.String `tfsdk:"id"`
+	Location    types.String `tfsdk:"location"`
+	Status      types.String `tfsdk:"status"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	CompletedAt types.String `tfsdk:"completed_at"`
+}
+
+func (model *DBaasBackupModel) fill(backup *dbaas.DBaaSBackup) {
+	model.Id = types.StringValue(backup.Id)
+	model.Location = types.StringValue(backup.Location)
+	model.Status = types.StringValue(backup.Status)
+	model.CreatedAt = utils.TimestampValue(backup.CreatedAt)
+	model.CompletedAt = utils.TimestampValue(backup.CompletedAt)
+}
+
+func (r *dbaasBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_backup"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *dbaasBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dbaasBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = getDbaasBackupResourceSchema()
+}
+
+func (r *dbaasBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DBaasBackupModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupId, err := r.client.DBaas.CreateBackup(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.DbaasId.ValueInt64(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when creating Backup",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the backup before waiting for it. If waiting fails, it stays in the state as tainted:
+	// it can still be read, but the next apply replaces it and triggers another backup.
+	data.Id = types.StringValue(backupId)
+	data.Location = types.StringNull()
+	data.Status = types.StringNull()
+	data.CreatedAt = types.StringNull()
+	data.CompletedAt = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	ctx, cancel := context.WithTimeout(ctx, backupTimeout)
+	defer cancel()
+
+	backup, err := waitUntilBackedUp(
+		ctx,
+		r.client.DBaas,
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.DbaasId.ValueInt64(),
+		backupId,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when waiting for Backup to be completed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.fill(backup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// waitUntilBackedUp polls the backup until the API reports it completed
+func waitUntilBackedUp(ctx context.Context, client dbaas.Api, publicCloudId, publicCloudProjectId, dbaasId int64, backupId string) (*dbaas.DBaaSBackup, error) {
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
+	for {
+		backup, err := client.GetBackup(publicCloudId, publicCloudProjectId, dbaasId, backupId)
+		if err != nil {
+			return nil, err
+		}
+
+		switch backup.Status {
+		case "completed":
+			return backup, nil
+		case "failed", "error":
+			return nil, fmt.Errorf("backup %s ended with status %s", backup.Id, backup.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func (r *dbaasBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DBaasBackupModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.DBaas.GetBackup(
+		state.PublicCloudId.ValueInt64(),
+		state.PublicCloudProjectId.ValueInt64(),
+		state.DbaasId.ValueInt64(),
+		state.Id.ValueString(),
+	)
+	// The backup expired or was removed outside of Terraform
+	if errors.Is(err, helpers.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when getting Backup",
+			err.Error(),
+		)
+		return
+	}
+
+	state.fill(backup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dbaasBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires a replacement, there is nothing to update in place
+	var data DBaasBackupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbaasBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DBaasBackupModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DBaas.DeleteBackup(
+		state.PublicCloudId.ValueInt64(),
+		state.PublicCloudProjectId.ValueInt64(),
+		state.DbaasId.ValueInt64(),
+		state.Id.ValueString(),
+	)
+	if err != nil && !errors.Is(err, helpers.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error when deleting DBaaS backup",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dbaasBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids, err := parseBackupRestoreImport(req)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_id"), ids.PublicCloudId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_project_id"), ids.PublicCloudProjectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dbaas_id"), ids.DbaasId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids.Id)...)
+}
@@ -0,0 +1,68 @@
+package dbaas
+
+import (
+	"regexp"
+	mockDBaas "terraform-provider-infomaniak/internal/apis/dbaas/mock"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDbaasBackupResource_Schema(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas_backup.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_backup_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_backup.backup", "id"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_backup.backup", "status", "completed"),
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_backup.backup", "location"),
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_backup.backup", "completed_at"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_backups.backups", "backups.#", "1"),
+						resource.TestCheckResourceAttrPair(
+							"data.infomaniak_dbaas_backups.backups", "backups.0.id",
+							"infomaniak_dbaas_backup.backup", "id",
+						),
+						resource.TestCheckResourceAttrSet("data.infomaniak_dbaas_backups.backups", "backups.0.created_at"),
+					),
+				},
+			},
+		},
+		"resource.dbaas_backup.wait_failed": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						mockDBaas.BackupStatus = "failed"
+					},
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_backup_good.tf"),
+					ExpectError: regexp.MustCompile(`ended with status failed`),
+				},
+				{
+					// The backup is kept in the state with its ids, so it can still be read
+					PreConfig: func() {
+						mockDBaas.BackupStatus = "completed"
+					},
+					RefreshState: true,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_backup.backup", "id"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_backup.backup", "public_cloud_id", "42"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_backup.backup", "public_cloud_project_id", "54"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_backup.backup", "status", "failed"),
+					),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package dbaas
+
+import (
+	"context"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dbaasBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dbaasBackupsDataSource{}
+)
+
+type dbaasBackupsDataSource struct {
+	client *apis.Client
+}
+
+// NewDBaasBackupsDataSource is a helper function to simplify the provider implementation.
+func NewDBaasBackupsDataSource() datasource.DataSource {
+	return &dbaasBackupsDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dbaasBackupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+type DBaasBackupsDataModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+	DbaasId              types.Int64 `tfsdk:"dbaas_id"`
+
+	Backups []DBaasBackupsItemModel `tfsdk:"backups"`
+}
+
+type DBaasBackupsItemModel struct {
+	Id          types.String `tfsdk:"id"`
+	Location    types.String `tfsdk:"location"`
+	Status      types.String `tfsdk:"status"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	CompletedAt types.String `tfsdk:"completed_at"`
+}
+
+// Schema defines the schema for the data source.
+func (d *dbaasBackupsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = getDbaasBackupsDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dbaasBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DBaasBackupsDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backups, err := d.client.DBaas.ListBackups(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.DbaasId.ValueInt64(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list DBaaS backups",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Backups = make([]DBaasBackupsItemModel, 0, len(backups))
+	for _, backup := range backups {
+		data.Backups = append(data.Backups, DBaasBackupsItemModel{
+			Id:          types.StringValue(backup.Id),
+			Location:    types.StringValue(backup.Location),
+			Status:      types.StringValue(backup.Status),
+			CreatedAt:   utils.TimestampValue(backup.CreatedAt),
+			CompletedAt: utils.TimestampValue(backup.CompletedAt),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Metadata returns the data source type name.
+func (d *dbaasBackupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_backups"
+}
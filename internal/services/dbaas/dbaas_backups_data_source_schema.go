@@ -0,0 +1,53 @@
+package dbaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func getDbaasBackupsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"public_cloud_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The id of the public cloud where DBaaS is installed",
+			},
+			"public_cloud_project_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The id of the public cloud project where DBaaS is installed",
+			},
+			"dbaas_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The id of the DBaaS",
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The backups of the DBaaS",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Backup identifier",
+						},
+						"location": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Where the backup is stored",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the backup",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The creation date of the backup, in RFC 3339 format",
+						},
+						"completed_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date the backup was completed, in RFC 3339 format",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "The dbaas backups data source lists the backups of a dbaas",
+	}
+}
@@ -0,0 +1,184 @@
+package dbaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/apis/helpers"
+	"terraform-provider-infomaniak/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// validateConfigurationValue checks a configuration value against the parameter of the engine catalog
+func validateConfigurationValue(parameter *dbaas.DBaaSConfigurationParameter, value attr.Value) error {
+	// Values only known after apply are checked by the API
+	if value.IsUnknown() {
+		return nil
+	}
+
+	switch parameter.Type {
+	case "integer", "float":
+		number, ok := configurationNumber(value)
+		if !ok {
+			return fmt.Errorf("%s must be a number", parameter.Name)
+		}
+		if parameter.Type == "integer" && !number.IsInt() {
+			return fmt.Errorf("%s must be an integer", parameter.Name)
+		}
+
+		float, _ := number.Float64()
+		if parameter.Min != nil && float < *parameter.Min {
+			return fmt.Errorf("%s must be at least %v, got %v", parameter.Name, *parameter.Min, float)
+		}
+		if parameter.Max != nil && float > *parameter.Max {
+			return fmt.Errorf("%s must be at most %v, got %v", parameter.Name, *parameter.Max, float)
+		}
+	case "boolean":
+		if _, ok := value.(basetypes.BoolValue); !ok {
+			return fmt.Errorf("%s must be a boolean", parameter.Name)
+		}
+	case "string":
+		str, ok := value.(basetypes.StringValue)
+		if !ok {
+			return fmt.Errorf("%s must be a string", parameter.Name)
+		}
+		if err := checkAllowedValue(parameter, str.ValueString()); err != nil {
+			return err
+		}
+	case "list":
+		tuple, ok := value.(basetypes.TupleValue)
+		if !ok {
+			return fmt.Errorf("%s must be a list of strings", parameter.Name)
+		}
+		for _, element := range tuple.Elements() {
+			str, ok := element.(basetypes.StringValue)
+			if !ok {
+				return fmt.Errorf("%s must be a list of strings", parameter.Name)
+			}
+			if err := checkAllowedValue(parameter, str.ValueString()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// configurationNumber reads the number a configuration value holds
+func configurationNumber(value attr.Value) (*big.Float, bool) {
+	switch typed := value.(type) {
+	case basetypes.NumberValue:
+		return typed.ValueBigFloat(), !typed.IsNull()
+	case basetypes.Int64Value:
+		return new(big.Float).SetInt64(typed.ValueInt64()), true
+	case basetypes.Float64Value:
+		return big.NewFloat(typed.ValueFloat64()), true
+	}
+	return nil, false
+}
+
+func checkAllowedValue(parameter *dbaas.DBaaSConfigurationParameter, value string) error {
+	if len(parameter.Values) == 0 || slices.Contains(parameter.Values, value) {
+		return nil
+	}
+	return fmt.Errorf("%q is not an allowed value of %s, allowed values are: %s", value, parameter.Name, strings.Join(parameter.Values, ", "))
+}
+
+// modifyConfigurationPlan checks the planned configuration against the catalog of the engine:
+// unknown keys and invalid values are rejected, changes restarting the database are warned about
+func (r *dbaasResource) modifyConfigurationPlan(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil {
+		return diags
+	}
+
+	var configuration, priorConfiguration types.Dynamic
+	var dbType, version types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("configuration"), &configuration)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &dbType)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &version)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("configuration"), &priorConfiguration)...)
+	}
+	if diags.HasError() || configuration.IsNull() || configuration.IsUnknown() || dbType.IsUnknown() || version.IsUnknown() {
+		return diags
+	}
+
+	catalog, err := r.client.DBaas.GetConfigurationSchema(dbType.ValueString(), version.ValueString())
+	// Without a catalog for this engine the configuration is only checked by the API
+	if errors.Is(err, helpers.ErrNotFound) {
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error when getting DBaaS configuration catalog",
+			err.Error(),
+		)
+		return diags
+	}
+
+	values, d := utils.ConvertDynamicObjectToTerraformMap(configuration)
+	diags.Append(d...)
+	priorValues, d := utils.ConvertDynamicObjectToTerraformMap(priorConfiguration)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var restarting []string
+	for _, key := range keys {
+		index := slices.IndexFunc(catalog, func(parameter *dbaas.DBaaSConfigurationParameter) bool {
+			return parameter.Name == key
+		})
+		if index < 0 {
+			diags.AddAttributeError(
+				path.Root("configuration"),
+				"Invalid DBaaS Configuration",
+				fmt.Sprintf("%s is not a configuration parameter of %s %s", key, dbType.ValueString(), version.ValueString()),
+			)
+			continue
+		}
+
+		parameter := catalog[index]
+		if err := validateConfigurationValue(parameter, values[key]); err != nil {
+			diags.AddAttributeError(
+				path.Root("configuration"),
+				"Invalid DBaaS Configuration",
+				err.Error(),
+			)
+			continue
+		}
+
+		prior, found := priorValues[key]
+		if parameter.RestartRequired && !req.State.Raw.IsNull() && (!found || !prior.Equal(values[key])) {
+			restarting = append(restarting, key)
+		}
+	}
+
+	if len(restarting) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("configuration"),
+			"DBaaS Restart Required",
+			fmt.Sprintf("Changing %s restarts the database, it is unavailable for the duration of the restart", strings.Join(restarting, ", ")),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,100 @@
+package dbaas
+
+import (
+	"math/big"
+	"regexp"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validateConfigurationValue", func() {
+	minimum, maximum := 10.0, 10000.0
+	maxConnections := &dbaas.DBaaSConfigurationParameter{Name: "max_connections", Type: "integer", Min: &minimum, Max: &maximum}
+	longQueryTime := &dbaas.DBaaSConfigurationParameter{Name: "long_query_time", Type: "float"}
+	slowQueryLog := &dbaas.DBaaSConfigurationParameter{Name: "slow_query_log", Type: "boolean"}
+	isolation := &dbaas.DBaaSConfigurationParameter{Name: "transaction_isolation", Type: "string", Values: []string{"READ-COMMITTED", "REPEATABLE-READ"}}
+	sqlMode := &dbaas.DBaaSConfigurationParameter{Name: "sql_mode", Type: "list", Values: []string{"NO_ZERO_DATE", "STRICT_TRANS_TABLES"}}
+
+	tuple := func(values ...string) attr.Value {
+		elementTypes := make([]attr.Type, 0, len(values))
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elementTypes = append(elementTypes, types.StringType)
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.TupleValueMust(elementTypes, elements)
+	}
+
+	DescribeTable("checking values against the catalog",
+		func(parameter *dbaas.DBaaSConfigurationParameter, value attr.Value, matcher OmegaMatcher) {
+			Expect(validateConfigurationValue(parameter, value)).To(matcher)
+		},
+		Entry("integer", maxConnections, types.NumberValue(big.NewFloat(300)), Succeed()),
+		Entry("integer_int64", maxConnections, types.Int64Value(300), Succeed()),
+		Entry("integer_bounds", maxConnections, types.NumberValue(big.NewFloat(10000)), Succeed()),
+		Entry("integer_unknown", maxConnections, types.NumberUnknown(), Succeed()),
+		Entry("integer_too_low", maxConnections, types.NumberValue(big.NewFloat(5)), MatchError(ContainSubstring("max_connections must be at least 10, got 5"))),
+		Entry("integer_too_high", maxConnections, types.NumberValue(big.NewFloat(100000)), MatchError(ContainSubstring("max_connections must be at most 10000, got 100000"))),
+		Entry("integer_fraction", maxConnections, types.NumberValue(big.NewFloat(300.5)), MatchError(ContainSubstring("max_connections must be an integer"))),
+		Entry("integer_string", maxConnections, types.StringValue("300"), MatchError(ContainSubstring("max_connections must be a number"))),
+		Entry("float", longQueryTime, types.NumberValue(big.NewFloat(2.5)), Succeed()),
+		Entry("boolean", slowQueryLog, types.BoolValue(true), Succeed()),
+		Entry("boolean_string", slowQueryLog, types.StringValue("ON"), MatchError(ContainSubstring("slow_query_log must be a boolean"))),
+		Entry("string", isolation, types.StringValue("READ-COMMITTED"), Succeed()),
+		Entry("string_not_allowed", isolation, types.StringValue("SERIALIZABLE"), MatchError(ContainSubstring(`"SERIALIZABLE" is not an allowed value of transaction_isolation`))),
+		Entry("list", sqlMode, tuple("STRICT_TRANS_TABLES", "NO_ZERO_DATE"), Succeed()),
+		Entry("list_empty", sqlMode, tuple(), Succeed()),
+		Entry("list_not_allowed", sqlMode, tuple("STRICT_TRANS_TABLES", "ANSI"), MatchError(ContainSubstring(`"ANSI" is not an allowed value of sql_mode`))),
+		Entry("list_single_string", sqlMode, types.StringValue("NO_ZERO_DATE"), MatchError(ContainSubstring("sql_mode must be a list of strings"))),
+		Entry("list_of_non_strings", sqlMode, types.TupleValueMust([]attr.Type{types.BoolType}, []attr.Value{types.BoolValue(true)}), MatchError(ContainSubstring("sql_mode must be a list of strings"))),
+	)
+})
+
+func TestDbaasResource_Configuration(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas.configuration_unknown_key": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_configuration_unknown_key.tf"),
+					ExpectError: regexp.MustCompile(`max_connection is not a configuration parameter of mysql 8.0`),
+				},
+			},
+		},
+		"resource.dbaas.configuration_out_of_range": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_configuration_out_of_range.tf"),
+					ExpectError: regexp.MustCompile(`max_connections must be at most 10000`),
+				},
+			},
+		},
+		"resource.dbaas.configuration_nested": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					// Nested objects are left to the API for engines without a catalog
+					Config:             test.MustGetTestFile("schema", "resource_dbaas_configuration_nested.tf"),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package dbaas
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"net/url"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// engineConnection describes how clients connect to a database engine
+type engineConnection struct {
+	Scheme string
+	// JdbcSubprotocol is empty for engines without a JDBC driver
+	JdbcSubprotocol string
+	DefaultDatabase string
+	TlsMode         string
+	// TlsParameter is the URI query parameter carrying TlsMode, empty when the scheme enables TLS
+	TlsParameter     string
+	JdbcTlsParameter string
+}
+
+var engineConnections = map[string]engineConnection{
+	"mysql": {
+		Scheme:           "mysql",
+		JdbcSubprotocol:  "mysql",
+		TlsMode:          "VERIFY_IDENTITY",
+		TlsParameter:     "ssl-mode",
+		JdbcTlsParameter: "sslMode",
+	},
+	"postgresql": {
+		Scheme:           "postgresql",
+		JdbcSubprotocol:  "postgresql",
+		DefaultDatabase:  "postgres",
+		TlsMode:          "verify-full",
+		TlsParameter:     "sslmode",
+		JdbcTlsParameter: "sslmode",
+	},
+	"redis": {
+		Scheme:          "rediss",
+		DefaultDatabase: "0",
+		TlsMode:         "tls",
+	},
+	"valkey": {
+		Scheme:          "rediss",
+		DefaultDatabase: "0",
+		TlsMode:         "tls",
+	},
+}
+
+// connectionUri builds the URI clients of the engine connect with, the password is left out when null
+func connectionUri(engine engineConnection, connection *dbaas.DBaaSConnectionInfo, password types.String) string {
+	uri := url.URL{
+		Scheme: engine.Scheme,
+		Host:   net.JoinHostPort(connection.Host, connection.Port),
+		Path:   "/" + engine.DefaultDatabase,
+		User:   url.User(connection.User),
+	}
+	if !password.IsNull() && !password.IsUnknown() {
+		uri.User = url.UserPassword(connection.User, password.ValueString())
+	}
+	if engine.TlsParameter != "" {
+		uri.RawQuery = url.Values{engine.TlsParameter: {engine.TlsMode}}.Encode()
+	}
+
+	return uri.String()
+}
+
+// jdbcUrl builds the JDBC URL of the engine, credentials are given as query parameters
+func jdbcUrl(engine engineConnection, connection *dbaas.DBaaSConnectionInfo, password types.String) string {
+	query := url.Values{
+		engine.JdbcTlsParameter: {engine.TlsMode},
+		"user":                  {connection.User},
+	}
+	if !password.IsNull() && !password.IsUnknown() {
+		query.Set("password", password.ValueString())
+	}
+
+	uri := url.URL{
+		Scheme:   engine.JdbcSubprotocol,
+		Host:     net.JoinHostPort(connection.Host, connection.Port),
+		Path:     "/" + engine.DefaultDatabase,
+		RawQuery: query.Encode(),
+	}
+
+	return "jdbc:" + uri.String()
+}
+
+// caExpiresAt reads the expiration date of the first certificate of a PEM bundle
+func caExpiresAt(ca string) types.String {
+	block, _ := pem.Decode([]byte(ca))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return types.StringNull()
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(certificate.NotAfter.UTC().Format(time.RFC3339))
+}
+
+type connectionDetails struct {
+	Uri             types.String
+	JdbcUrl         types.String
+	DefaultDatabase types.String
+	TlsMode         types.String
+	CaExpiresAt     types.String
+}
+
+// newConnectionDetails derives the connection details of a DBaaS from its connection information and type
+func newConnectionDetails(dbType string, connection *dbaas.DBaaSConnectionInfo, password types.String) connectionDetails {
+	details := connectionDetails{
+		Uri:             types.StringNull(),
+		JdbcUrl:         types.StringNull(),
+		DefaultDatabase: types.StringNull(),
+		TlsMode:         types.StringNull(),
+		CaExpiresAt:     types.StringNull(),
+	}
+	if connection == nil {
+		return details
+	}
+
+	details.CaExpiresAt = caExpiresAt(connection.Ca)
+
+	engine, found := engineConnections[dbType]
+	if !found {
+		return details
+	}
+
+	details.Uri = types.StringValue(connectionUri(engine, connection, password))
+	details.TlsMode = types.StringValue(engine.TlsMode)
+	if engine.DefaultDatabase != "" {
+		details.DefaultDatabase = types.StringValue(engine.DefaultDatabase)
+	}
+	if engine.JdbcSubprotocol != "" {
+		details.JdbcUrl = types.StringValue(jdbcUrl(engine, connection, password))
+	}
+
+	return details
+}
@@ -2,9 +2,12 @@ package dbaas
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"terraform-provider-infomaniak/internal/apis"
 	"terraform-provider-infomaniak/internal/apis/dbaas"
 	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -62,15 +65,26 @@ type DBaasDataModel struct {
 	User types.String `tfsdk:"user"`
 	Ca   types.String `tfsdk:"ca"`
 
+	Uri         types.String `tfsdk:"uri"`
+	JdbcUrl     types.String `tfsdk:"jdbc_url"`
+	Database    types.String `tfsdk:"database"`
+	TlsMode     types.String `tfsdk:"tls_mode"`
+	CaExpiresAt types.String `tfsdk:"ca_expires_at"`
+
 	AllowedCIDRs types.List `tfsdk:"allowed_cidrs"`
 
 	EffectiveConfiguration types.Dynamic `tfsdk:"effective_configuration"`
+
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
 }
 
 func (data *DBaasDataModel) fill(obj *dbaas.DBaaS) {
 	data.Region = types.StringValue(obj.Region)
 	data.Name = types.StringValue(obj.Name)
 	data.PackName = types.StringValue(obj.Pack.Name)
+	data.Status = types.StringValue(obj.Status)
+	data.CreatedAt = utils.TimestampValue(obj.CreatedAt)
 	data.Region = types.StringValue(obj.Region)
 	data.Type = types.StringValue(obj.Type)
 	data.Version = types.StringValue(obj.Version)
@@ -80,6 +94,14 @@ func (data *DBaasDataModel) fill(obj *dbaas.DBaaS) {
 		data.User = types.StringValue(obj.Connection.User)
 		data.Ca = types.StringValue(obj.Connection.Ca)
 	}
+
+	// The admin password is not exposed by the data source
+	details := newConnectionDetails(obj.Type, obj.Connection, types.StringNull())
+	data.Uri = details.Uri
+	data.JdbcUrl = details.JdbcUrl
+	data.Database = details.DefaultDatabase
+	data.TlsMode = details.TlsMode
+	data.CaExpiresAt = details.CaExpiresAt
 	data.KubernetesIdentifier = types.StringValue(obj.KubernetesIdentifier)
 }
 
@@ -93,6 +115,21 @@ func (d *dbaasDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	var data DBaasDataModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() {
+		id, err := d.findIdByName(data)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to find DBaaS",
+				err.Error(),
+			)
+			return
+		}
+		data.Id = types.Int64Value(id)
+	}
 
 	obj, err := d.client.DBaas.GetDBaaS(
 		data.PublicCloudId.ValueInt64(),
@@ -146,6 +183,32 @@ func (d *dbaasDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 }
 
+// findIdByName looks up the id of the only DBaaS of the project with the configured name
+func (d *dbaasDataSource) findIdByName(data DBaasDataModel) (int64, error) {
+	list, err := d.client.DBaas.ListDBaaS(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		dbaas.DBaaSFilter{Name: data.Name.ValueStringPointer()},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// The API may match names partially
+	list = slices.DeleteFunc(list, func(obj *dbaas.DBaaS) bool {
+		return obj.Name != data.Name.ValueString()
+	})
+
+	switch len(list) {
+	case 0:
+		return 0, fmt.Errorf("no DBaaS named %q in project %d", data.Name.ValueString(), data.PublicCloudProjectId.ValueInt64())
+	case 1:
+		return list[0].Id, nil
+	default:
+		return 0, fmt.Errorf("%d DBaaS are named %q in project %d, use id to choose one", len(list), data.Name.ValueString(), data.PublicCloudProjectId.ValueInt64())
+	}
+}
+
 // Metadata returns the data source type name.
 func (d *dbaasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dbaas"
@@ -1,7 +1,10 @@
 package dbaas
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,12 +20,17 @@ func getDbaasDataSourceSchema() schema.Schema {
 				MarkdownDescription: "The id of the public cloud project where DBaaS is installed",
 			},
 			"id": schema.Int64Attribute{
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: "The id of this DBaaS",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The name of the DBaaS project",
+				MarkdownDescription: "The name of the DBaaS project, to look the DBaaS up when its id is not given",
 			},
 			"pack_name": schema.StringAttribute{
 				Computed:            true,
@@ -56,6 +64,28 @@ func getDbaasDataSourceSchema() schema.Schema {
 				Computed:            true,
 				MarkdownDescription: "The Database CA Certificate",
 			},
+			"uri": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The URI to connect to the database, without the admin password.",
+			},
+			"jdbc_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The JDBC URL to connect to the database, without the admin password, only set for engines with a JDBC driver.",
+			},
+			"database": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The database connected to by default, e.g. `postgres` for PostgreSQL.",
+			},
+			"tls_mode": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The TLS mode set in `uri` and `jdbc_url`, e.g. `VERIFY_IDENTITY` for MySQL.",
+			},
+			"ca_expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The expiration date of the Database CA Certificate, in RFC 3339 format.",
+			},
 			"allowed_cidrs": schema.ListAttribute{
 				Computed:            true,
 				ElementType:         types.StringType,
@@ -65,6 +95,19 @@ func getDbaasDataSourceSchema() schema.Schema {
 				Computed:            true,
 				MarkdownDescription: "DbaaS kubernetes name",
 			},
+			"tags": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the DBaaS",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the DBaaS, `ready` once the database is available",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The creation date of the DBaaS, in RFC 3339 format",
+			},
 			"effective_configuration": schema.DynamicAttribute{
 				Computed:            true,
 				MarkdownDescription: "The effective database configuration settings",
@@ -20,10 +20,59 @@ func TestDbaasDatasource_Schema(t *testing.T) {
 						resource.TestCheckResourceAttr("data.infomaniak_dbaas.db", "type", "mysql"),
 						resource.TestCheckResourceAttr("data.infomaniak_dbaas.db", "effective_configuration.max_connections", "200"),
 						resource.TestCheckResourceAttrSet("data.infomaniak_dbaas.db", "ca"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas.db", "ca_expires_at", "2036-10-16T10:19:22Z"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas.db", "uri", "mysql://root@localhost:3306/?ssl-mode=VERIFY_IDENTITY"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas.db", "tls_mode", "VERIFY_IDENTITY"),
+						resource.TestCheckNoResourceAttr("data.infomaniak_dbaas.db", "database"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas.db", "status", "ready"),
+						resource.TestCheckResourceAttrSet("data.infomaniak_dbaas.db", "created_at"),
 					),
 				},
 			},
 		},
+		"data_source.dbaas.by_name": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "data_source_dbaas_list_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair("data.infomaniak_dbaas.app", "id", "infomaniak_dbaas.app", "id"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas.app", "name", "list-app"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_list.all", "dbaas.#", "2"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_list.worker", "dbaas.#", "1"),
+						resource.TestCheckResourceAttrPair("data.infomaniak_dbaas_list.worker", "dbaas.0.id", "infomaniak_dbaas.worker", "id"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_list.worker", "dbaas.0.pack_name", "essential-1"),
+					),
+				},
+			},
+		},
+		"data_source.dbaas.name_duplicated": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "data_source_dbaas_name_duplicated.tf"),
+					ExpectError: regexp.MustCompile(`2 DBaaS are named "list-twin"`),
+				},
+			},
+		},
+		"data_source.dbaas.name_missing": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "data_source_dbaas_name_missing.tf"),
+					ExpectError: regexp.MustCompile(`no DBaaS named "list-missing"`),
+				},
+			},
+		},
+		"data_source.dbaas.id_and_name": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "data_source_dbaas_id_and_name.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		},
 		"data_source.dbaas.cant_specify_region": {
 			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
 			Steps: []resource.TestStep{
@@ -0,0 +1,218 @@
+package dbaas
+
+import (
+	"context"
+	"errors"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/apis/helpers"
+	"terraform-provider-infomaniak/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &dbaasDatabaseResource{}
+	_ resource.ResourceWithConfigure   = &dbaasDatabaseResource{}
+	_ resource.ResourceWithImportState = &dbaasDatabaseResource{}
+)
+
+func NewDBaasDatabaseResource() resource.Resource {
+	return &dbaasDatabaseResource{}
+}
+
+type dbaasDatabaseResource struct {
+	client *apis.Client
+}
+
+type DBaasDatabaseModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+	DbaasId              types.Int64 `tfsdk:"dbaas_id"`
+
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	CharacterSet types.String `tfsdk:"character_set"`
+	Collation    types.String `tfsdk:"collation"`
+}
+
+func (model *DBaasDatabaseModel) fill(database *dbaas.DBaaSDatabase) {
+	model.Id = types.StringValue(database.Name)
+	model.Name = types.StringValue(database.Name)
+	model.CharacterSet = types.StringValue(database.CharacterSet)
+	model.Collation = types.StringValue(database.Collation)
+}
+
+func (r *dbaasDatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_database"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *dbaasDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dbaasDatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = getDbaasDatabaseResourceSchema()
+}
+
+func (r *dbaasDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DBaasDatabaseModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &dbaas.DBaaSDatabase{
+		Name:         data.Name.ValueString(),
+		CharacterSet: data.CharacterSet.ValueString(),
+		Collation:    data.Collation.ValueString(),
+	}
+
+	ok, err := r.client.DBaas.CreateDatabase(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.DbaasId.ValueInt64(),
+		input,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when creating Database",
+			err.Error(),
+		)
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error when creating Database",
+			"CreateDatabase returned false but no error was provided",
+		)
+		return
+	}
+
+	// The character set and collation are defaulted by the engine when not given
+	database, err := r.client.DBaas.GetDatabase(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.DbaasId.ValueInt64(),
+		input.Name,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when getting Database",
+			err.Error(),
+		)
+		return
+	}
+
+	data.fill(database)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbaasDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DBaasDatabaseModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.DBaas.GetDatabase(
+		state.PublicCloudId.ValueInt64(),
+		state.PublicCloudProjectId.ValueInt64(),
+		state.DbaasId.ValueInt64(),
+		state.Id.ValueString(),
+	)
+	if errors.Is(err, helpers.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when getting Database",
+			err.Error(),
+		)
+		return
+	}
+
+	state.fill(database)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dbaasDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to update in place
+	var data DBaasDatabaseModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbaasDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DBaasDatabaseModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DBaas.DeleteDatabase(
+		state.PublicCloudId.ValueInt64(),
+		state.PublicCloudProjectId.ValueInt64(),
+		state.DbaasId.ValueInt64(),
+		state.Id.ValueString(),
+	)
+	if err != nil && !errors.Is(err, helpers.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error when deleting Database",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dbaasDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids, err := parseBackupRestoreImport(req)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_id"), ids.PublicCloudId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_project_id"), ids.PublicCloudProjectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dbaas_id"), ids.DbaasId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids.Id)...)
+}
@@ -0,0 +1,81 @@
+package dbaas
+
+import (
+	"strconv"
+	mockDBaas "terraform-provider-infomaniak/internal/apis/dbaas/mock"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDbaasDatabaseResource_Schema(t *testing.T) {
+	var dbaasId int64
+
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas_database.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_database_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas_database.app", "id", "app"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_database.app", "name", "app"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_database.app", "character_set", "utf8mb4"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_database.app", "collation", "utf8mb4_0900_ai_ci"),
+					),
+				},
+				{
+					ResourceName:      "infomaniak_dbaas_database.app",
+					ImportState:       true,
+					ImportStateIdFunc: importStateId("infomaniak_dbaas_database.app"),
+					ImportStateVerify: true,
+				},
+			},
+		},
+		"resource.dbaas_database.character_set": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_database_latin1.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas_database.app", "character_set", "latin1"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_database.app", "collation", "latin1_swedish_ci"),
+					),
+				},
+			},
+		},
+		"resource.dbaas_database.not_found": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_database_good.tf"),
+					Check: resource.TestCheckResourceAttrWith("infomaniak_dbaas_database.app", "dbaas_id", func(value string) error {
+						id, err := strconv.ParseInt(value, 10, 64)
+						dbaasId = id
+						return err
+					}),
+				},
+				{
+					// A database deleted outside of terraform is removed from the state and planned again
+					PreConfig: func() {
+						if _, err := (&mockDBaas.Client{}).DeleteDatabase(42, 54, dbaasId, "app"); err != nil {
+							t.Fatalf("unexpected error: %v", err)
+						}
+					},
+					Config:             test.MustGetTestFile("schema", "resource_dbaas_database_good.tf"),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package dbaas
+
+import (
+	"context"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dbaasListDataSource{}
+	_ datasource.DataSourceWithConfigure = &dbaasListDataSource{}
+)
+
+type dbaasListDataSource struct {
+	client *apis.Client
+}
+
+// NewDBaasListDataSource is a helper function to simplify the provider implementation.
+func NewDBaasListDataSource() datasource.DataSource {
+	return &dbaasListDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dbaasListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+type DBaasListDataModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+
+	Type   types.String `tfsdk:"type"`
+	Region types.String `tfsdk:"region"`
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+
+	Dbaas []DBaasListItemModel `tfsdk:"dbaas"`
+}
+
+type DBaasListItemModel struct {
+	Id                   types.Int64  `tfsdk:"id"`
+	KubernetesIdentifier types.String `tfsdk:"kube_identifier"`
+	Name                 types.String `tfsdk:"name"`
+	PackName             types.String `tfsdk:"pack_name"`
+	Region               types.String `tfsdk:"region"`
+	Type                 types.String `tfsdk:"type"`
+	Version              types.String `tfsdk:"version"`
+	Host                 types.String `tfsdk:"host"`
+	Port                 types.String `tfsdk:"port"`
+	Status               types.String `tfsdk:"status"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+}
+
+func (item *DBaasListItemModel) fill(obj *dbaas.DBaaS) {
+	item.Id = types.Int64Value(obj.Id)
+	item.KubernetesIdentifier = types.StringValue(obj.KubernetesIdentifier)
+	item.Name = types.StringValue(obj.Name)
+	item.PackName = types.StringNull()
+	if obj.Pack != nil {
+		item.PackName = types.StringValue(obj.Pack.Name)
+	}
+	item.Region = types.StringValue(obj.Region)
+	item.Type = types.StringValue(obj.Type)
+	item.Version = types.StringValue(obj.Version)
+	item.Host = types.StringNull()
+	item.Port = types.StringNull()
+	if obj.Connection != nil {
+		item.Host = types.StringValue(obj.Connection.Host)
+		item.Port = types.StringValue(obj.Connection.Port)
+	}
+	item.Status = types.StringValue(obj.Status)
+	item.CreatedAt = utils.TimestampValue(obj.CreatedAt)
+}
+
+// Schema defines the schema for the data source.
+func (d *dbaasListDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = getDbaasListDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dbaasListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DBaasListDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.DBaas.ListDBaaS(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		dbaas.DBaaSFilter{
+			Type:   data.Type.ValueStringPointer(),
+			Region: data.Region.ValueStringPointer(),
+			Name:   data.Name.ValueStringPointer(),
+			Status: data.Status.ValueStringPointer(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list DBaaS",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Dbaas = make([]DBaasListItemModel, 0, len(list))
+	for _, obj := range list {
+		var item DBaasListItemModel
+		item.fill(obj)
+		data.Dbaas = append(data.Dbaas, item)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Metadata returns the data source type name.
+func (d *dbaasListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_list"
+}
@@ -0,0 +1,89 @@
+package dbaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func getDbaasListDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"public_cloud_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The id of the public cloud where DBaaS are installed",
+			},
+			"public_cloud_project_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The id of the public cloud project where DBaaS are installed",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the DBaaS of this database type",
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the DBaaS of this region",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the DBaaS with this name",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the DBaaS with this status",
+			},
+			"dbaas": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The DBaaS of the project",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the DBaaS",
+						},
+						"kube_identifier": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The kubernetes identifier of the DBaaS",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the DBaaS",
+						},
+						"pack_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the pack associated to the DBaaS",
+						},
+						"region": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The region where the DBaaS resides in",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the database",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the database",
+						},
+						"host": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The host to access the database",
+						},
+						"port": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The port to access the database",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the DBaaS",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The creation date of the DBaaS, in RFC 3339 format",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "The dbaas list data source lists the DBaaS of a public cloud project",
+	}
+}
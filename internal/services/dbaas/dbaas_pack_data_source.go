@@ -67,9 +67,23 @@ type PricingModel struct {
 	HourlyIncludingTaxes types.Float64 `tfsdk:"hour_incl_tax"`
 }
 
+func newRatesModel(rates dbaas.Rates) *RatesModel {
+	return &RatesModel{
+		CHF: newPricingModel(rates.CHF),
+		EUR: newPricingModel(rates.EUR),
+	}
+}
+
+func newPricingModel(pricing dbaas.Pricing) *PricingModel {
+	return &PricingModel{
+		HourlyExcludingTaxes: types.Float64Value(pricing.HourExclTax),
+		HourlyIncludingTaxes: types.Float64Value(pricing.HourInclTax),
+	}
+}
+
 // Schema defines the schema for the data source.
 func (d *dbaasPackDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = getDbaasPacksDataSourceSchema()
+	resp.Schema = getDbaasPackDataSourceSchema()
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -103,6 +117,7 @@ func (d *dbaasPackDataSource) Read(ctx context.Context, req datasource.ReadReque
 	data.CPU = types.Int64Value(pack.CPU)
 	data.RAM = types.Int64Value(pack.RAM)
 	data.Storage = types.Int64Value(pack.Storage)
+	data.Rates = newRatesModel(pack.Rates)
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
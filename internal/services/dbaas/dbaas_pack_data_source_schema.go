@@ -2,7 +2,7 @@ package dbaas
 
 import "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
-func getDbaasPacksDataSourceSchema() schema.Schema {
+func getDbaasPackDataSourceSchema() schema.Schema {
 	pricingObject := schema.SingleNestedAttribute{
 		Computed: true,
 		Attributes: map[string]schema.Attribute{
@@ -0,0 +1,196 @@
+package dbaas
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dbaasPacksDataSource{}
+	_ datasource.DataSourceWithConfigure = &dbaasPacksDataSource{}
+)
+
+type dbaasPacksDataSource struct {
+	client *apis.Client
+}
+
+// NewDBaasPacksDataSource is a helper function to simplify the provider implementation.
+func NewDBaasPacksDataSource() datasource.DataSource {
+	return &dbaasPacksDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dbaasPacksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+type DBaasPacksDataModel struct {
+	Type      types.String `tfsdk:"type"`
+	Group     types.String `tfsdk:"group"`
+	Name      types.String `tfsdk:"name"`
+	Instances types.Int64  `tfsdk:"instances"`
+	CPU       types.Int64  `tfsdk:"cpu"`
+	RAM       types.Int64  `tfsdk:"ram"`
+	Storage   types.Int64  `tfsdk:"storage"`
+
+	MinInstances types.Int64 `tfsdk:"min_instances"`
+	MaxInstances types.Int64 `tfsdk:"max_instances"`
+	MinCPU       types.Int64 `tfsdk:"min_cpu"`
+	MaxCPU       types.Int64 `tfsdk:"max_cpu"`
+	MinRAM       types.Int64 `tfsdk:"min_ram"`
+	MaxRAM       types.Int64 `tfsdk:"max_ram"`
+	MinStorage   types.Int64 `tfsdk:"min_storage"`
+	MaxStorage   types.Int64 `tfsdk:"max_storage"`
+
+	Packs    []DBaasPacksItemModel `tfsdk:"packs"`
+	Cheapest *DBaasPacksItemModel  `tfsdk:"cheapest"`
+}
+
+type DBaasPacksItemModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Group     types.String `tfsdk:"group"`
+	Name      types.String `tfsdk:"name"`
+	Instances types.Int64  `tfsdk:"instances"`
+	CPU       types.Int64  `tfsdk:"cpu"`
+	RAM       types.Int64  `tfsdk:"ram"`
+	Storage   types.Int64  `tfsdk:"storage"`
+	Rates     *RatesModel  `tfsdk:"rates"`
+}
+
+func (item *DBaasPacksItemModel) fill(pack *dbaas.Pack) {
+	item.ID = types.Int64Value(pack.ID)
+	item.Group = types.StringValue(pack.Group)
+	item.Name = types.StringValue(pack.Name)
+	item.Instances = types.Int64Value(pack.Instances)
+	item.CPU = types.Int64Value(pack.CPU)
+	item.RAM = types.Int64Value(pack.RAM)
+	item.Storage = types.Int64Value(pack.Storage)
+	item.Rates = newRatesModel(pack.Rates)
+}
+
+// packBounds are the inclusive ranges a pack must fit in, nil bounds are not checked
+type packBounds struct {
+	MinInstances, MaxInstances *int64
+	MinCPU, MaxCPU             *int64
+	MinRAM, MaxRAM             *int64
+	MinStorage, MaxStorage     *int64
+}
+
+func (bounds packBounds) contains(pack *dbaas.Pack) bool {
+	within := func(value int64, min, max *int64) bool {
+		return (min == nil || value >= *min) && (max == nil || value <= *max)
+	}
+
+	return within(pack.Instances, bounds.MinInstances, bounds.MaxInstances) &&
+		within(pack.CPU, bounds.MinCPU, bounds.MaxCPU) &&
+		within(pack.RAM, bounds.MinRAM, bounds.MaxRAM) &&
+		within(pack.Storage, bounds.MinStorage, bounds.MaxStorage)
+}
+
+// rankPacks keeps the packs within bounds, sorted from the cheapest to the most expensive hourly price in CHF
+func rankPacks(packs []*dbaas.Pack, bounds packBounds) []*dbaas.Pack {
+	ranked := make([]*dbaas.Pack, 0, len(packs))
+	for _, pack := range packs {
+		if bounds.contains(pack) {
+			ranked = append(ranked, pack)
+		}
+	}
+
+	slices.SortStableFunc(ranked, func(a, b *dbaas.Pack) int {
+		return cmp.Or(
+			cmp.Compare(a.Rates.CHF.HourExclTax, b.Rates.CHF.HourExclTax),
+			cmp.Compare(a.Rates.EUR.HourExclTax, b.Rates.EUR.HourExclTax),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return ranked
+}
+
+// Schema defines the schema for the data source.
+func (d *dbaasPacksDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = getDbaasPacksDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dbaasPacksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DBaasPacksDataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packs, err := d.client.DBaas.ListDbaasPacks(dbaas.PackFilter{
+		DbType:    data.Type.ValueString(),
+		Group:     data.Group.ValueStringPointer(),
+		Name:      data.Name.ValueStringPointer(),
+		Instances: data.Instances.ValueInt64Pointer(),
+		Cpu:       data.CPU.ValueInt64Pointer(),
+		Ram:       data.RAM.ValueInt64Pointer(),
+		Storage:   data.Storage.ValueInt64Pointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list DBaaS packs",
+			err.Error(),
+		)
+		return
+	}
+
+	ranked := rankPacks(packs, packBounds{
+		MinInstances: data.MinInstances.ValueInt64Pointer(),
+		MaxInstances: data.MaxInstances.ValueInt64Pointer(),
+		MinCPU:       data.MinCPU.ValueInt64Pointer(),
+		MaxCPU:       data.MaxCPU.ValueInt64Pointer(),
+		MinRAM:       data.MinRAM.ValueInt64Pointer(),
+		MaxRAM:       data.MaxRAM.ValueInt64Pointer(),
+		MinStorage:   data.MinStorage.ValueInt64Pointer(),
+		MaxStorage:   data.MaxStorage.ValueInt64Pointer(),
+	})
+
+	data.Packs = make([]DBaasPacksItemModel, len(ranked))
+	for i, pack := range ranked {
+		data.Packs[i].fill(pack)
+	}
+
+	data.Cheapest = nil
+	if len(data.Packs) > 0 {
+		cheapest := data.Packs[0]
+		data.Cheapest = &cheapest
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dbaasPacksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_packs"
+}
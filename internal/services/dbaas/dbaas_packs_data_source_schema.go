@@ -0,0 +1,137 @@
+package dbaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func getDbaasPacksDataSourceSchema() schema.Schema {
+	pricingObject := schema.SingleNestedAttribute{
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"hour_excl_tax": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The hourly price excluding taxes",
+			},
+			"hour_incl_tax": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The hourly price including taxes",
+			},
+		},
+	}
+
+	packAttributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The id of the pack",
+		},
+		"group": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The group of the pack",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the pack",
+		},
+		"instances": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of instances of the pack",
+		},
+		"cpu": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of CPU of the pack",
+		},
+		"ram": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The amount of RAM of the pack, in GB",
+		},
+		"storage": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The storage of the pack, in GB",
+		},
+		"rates": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The prices of the pack",
+			Attributes: map[string]schema.Attribute{
+				"chf": pricingObject,
+				"eur": pricingObject,
+			},
+		},
+	}
+
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The database type of the packs",
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs of this group",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the pack with this name",
+			},
+			"instances": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with exactly this number of instances",
+			},
+			"cpu": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with exactly this number of CPU",
+			},
+			"ram": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with exactly this amount of RAM, in GB",
+			},
+			"storage": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with exactly this storage, in GB",
+			},
+			"min_instances": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with at least this number of instances",
+			},
+			"max_instances": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with at most this number of instances",
+			},
+			"min_cpu": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with at least this number of CPU",
+			},
+			"max_cpu": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with at most this number of CPU",
+			},
+			"min_ram": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with at least this amount of RAM, in GB",
+			},
+			"max_ram": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with at most this amount of RAM, in GB",
+			},
+			"min_storage": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with at least this storage, in GB",
+			},
+			"max_storage": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the packs with at most this storage, in GB",
+			},
+			"packs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching packs, from the cheapest to the most expensive hourly price excluding taxes in CHF",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: packAttributes,
+				},
+			},
+			"cheapest": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The cheapest matching pack, null when no pack matches",
+				Attributes:          packAttributes,
+			},
+		},
+	}
+}
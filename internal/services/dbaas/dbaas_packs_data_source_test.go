@@ -0,0 +1,73 @@
+package dbaas
+
+import (
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rankPacks", func() {
+	price := func(chf, eur float64) dbaas.Rates {
+		return dbaas.Rates{CHF: dbaas.Pricing{HourExclTax: chf}, EUR: dbaas.Pricing{HourExclTax: eur}}
+	}
+	packs := []*dbaas.Pack{
+		{Name: "business-1", Instances: 3, CPU: 4, RAM: 16, Storage: 80, Rates: price(0.45, 0.48)},
+		{Name: "essential-1", Instances: 1, CPU: 1, RAM: 4, Storage: 20, Rates: price(0.05, 0.055)},
+		{Name: "essential-4", Instances: 1, CPU: 4, RAM: 16, Storage: 80, Rates: price(0.2, 0.22)},
+		{Name: "essential-4b", Instances: 1, CPU: 4, RAM: 16, Storage: 80, Rates: price(0.2, 0.21)},
+		{Name: "essential-4a", Instances: 1, CPU: 4, RAM: 16, Storage: 80, Rates: price(0.2, 0.21)},
+	}
+	int64Pointer := func(value int64) *int64 { return &value }
+
+	DescribeTable("filtering and ordering the packs",
+		func(bounds packBounds, expected []string) {
+			names := make([]string, 0, len(expected))
+			for _, pack := range rankPacks(packs, bounds) {
+				names = append(names, pack.Name)
+			}
+			Expect(names).To(Equal(expected))
+		},
+		Entry("no_bounds", packBounds{}, []string{"essential-1", "essential-4a", "essential-4b", "essential-4", "business-1"}),
+		Entry("min_cpu_and_ram", packBounds{MinCPU: int64Pointer(4), MinRAM: int64Pointer(16)}, []string{"essential-4a", "essential-4b", "essential-4", "business-1"}),
+		Entry("max_instances", packBounds{MinCPU: int64Pointer(4), MaxInstances: int64Pointer(1)}, []string{"essential-4a", "essential-4b", "essential-4"}),
+		Entry("inclusive_bounds", packBounds{MinStorage: int64Pointer(20), MaxStorage: int64Pointer(20)}, []string{"essential-1"}),
+		Entry("no_match", packBounds{MinCPU: int64Pointer(64)}, []string{}),
+	)
+})
+
+func TestDbaasPacksDatasource_Schema(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"data_source.dbaas_packs.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "data_source_dbaas_packs_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.large", "packs.#", "3"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.large", "packs.0.name", "essential-4"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.large", "packs.1.name", "business-1"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.large", "packs.2.name", "business-2"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.large", "packs.0.rates.chf.hour_excl_tax", "0.2"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.large", "packs.0.rates.eur.hour_excl_tax", "0.22"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.large", "cheapest.name", "essential-4"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.large", "cheapest.cpu", "4"),
+						resource.TestCheckResourceAttr("data.infomaniak_dbaas_packs.none", "packs.#", "0"),
+						resource.TestCheckNoResourceAttr("data.infomaniak_dbaas_packs.none", "cheapest.name"),
+					),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
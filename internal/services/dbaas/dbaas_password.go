@@ -0,0 +1,67 @@
+package dbaas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// updateAdminPassword resets the admin password when password_wo_version or rotate_password changed
+// and returns the password to keep in the state. prior is nil when the DBaaS was just created.
+func (r *dbaasResource) updateAdminPassword(ctx context.Context, config tfsdk.Config, data DBaasModel, prior *DBaasModel, current types.String) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	passwordWoChanged := !data.PasswordWoVersion.IsNull()
+	rotationChanged := false
+	if prior != nil {
+		passwordWoChanged = !data.PasswordWoVersion.Equal(prior.PasswordWoVersion)
+		rotationChanged = !data.RotatePassword.IsNull() && !data.RotatePassword.Equal(prior.RotatePassword)
+	}
+
+	var password string
+	var stored types.String
+	switch {
+	case passwordWoChanged && !data.PasswordWoVersion.IsNull():
+		passwordWo, d := configuredPassword(ctx, config)
+		diags.Append(d...)
+		if diags.HasError() {
+			return current, diags
+		}
+		// The write-only password is never stored
+		password, stored = passwordWo.ValueString(), types.StringNull()
+	case rotationChanged:
+		generated, err := generatePassword(generatedPasswordLength)
+		if err != nil {
+			diags.AddError(
+				"Error when generating DBaaS password",
+				err.Error(),
+			)
+			return current, diags
+		}
+		password, stored = generated, types.StringValue(generated)
+	default:
+		return current, diags
+	}
+
+	ok, err := r.client.DBaas.ResetPassword(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.Id.ValueInt64(),
+		password,
+	)
+	if err != nil {
+		diags.AddError(
+			"Error when resetting DBaaS password",
+			err.Error(),
+		)
+		return current, diags
+	}
+	if !ok {
+		diags.AddError("Unknown password reset error", "")
+		return current, diags
+	}
+
+	return stored, diags
+}
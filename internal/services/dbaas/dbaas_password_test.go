@@ -0,0 +1,109 @@
+package dbaas
+
+import (
+	"fmt"
+	"regexp"
+	mockDBaas "terraform-provider-infomaniak/internal/apis/dbaas/mock"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// checkMockedPassword ensures the DBaaS held by the mock has the expected admin password
+func checkMockedPassword(name string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ids, _, err := test.GetIdsFromState(s, name, "public_cloud_id", "public_cloud_project_id", "id")
+		if err != nil {
+			return err
+		}
+
+		obj, err := (&mockDBaas.Client{}).GetDBaaS(ids[0], ids[1], ids[2])
+		if err != nil {
+			return err
+		}
+		if obj.Connection.Password != expected {
+			return fmt.Errorf("expected the password %q, got %q", expected, obj.Connection.Password)
+		}
+		return nil
+	}
+}
+
+func TestDbaasResource_Password(t *testing.T) {
+	var rotated string
+
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas.rotate_password": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_rotate_password.tf"),
+					Check: resource.TestCheckResourceAttrWith("infomaniak_dbaas.db", "password", func(value string) error {
+						rotated = value
+						return nil
+					}),
+				},
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_rotate_password_again.tf"),
+					Check: resource.TestCheckResourceAttrWith("infomaniak_dbaas.db", "password", func(value string) error {
+						if value == rotated {
+							return fmt.Errorf("the password was not rotated")
+						}
+						if len(value) != generatedPasswordLength {
+							return fmt.Errorf("expected a generated password, got %d characters", len(value))
+						}
+						return nil
+					}),
+				},
+			},
+		},
+		"resource.dbaas.password_wo": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_password_wo.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckNoResourceAttr("infomaniak_dbaas.db", "password"),
+						resource.TestCheckNoResourceAttr("infomaniak_dbaas.db", "password_wo"),
+						checkMockedPassword("infomaniak_dbaas.db", "a-long-enough-password"),
+					),
+				},
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_password_wo_bumped.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckNoResourceAttr("infomaniak_dbaas.db", "password"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas.db", "password_wo_version", "2"),
+						checkMockedPassword("infomaniak_dbaas.db", "another-long-enough-password"),
+					),
+				},
+			},
+		},
+		"resource.dbaas.password_wo_without_version": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_password_wo_without_version.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		},
+		"resource.dbaas.password_wo_and_rotation": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_password_wo_and_rotation.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resizeTimeout bounds how long Update waits for a DBaaS to run its new pack
+const resizeTimeout = time.Hour
+
+// validatePackChange checks a pack change can be applied in place,
+// the new pack must be of the same type and keep at least the same storage
+func validatePackChange(current, next *dbaas.DBaaSPack) error {
+	if current.Type != "" && next.Type != "" && current.Type != next.Type {
+		return fmt.Errorf("pack %s is for %s databases, it cannot replace pack %s of a %s database", next.Name, next.Type, current.Name, current.Type)
+	}
+	if next.Storage < current.Storage {
+		return fmt.Errorf("pack %s has %d GB of storage, less than the %d GB of pack %s: storage cannot be shrunk", next.Name, next.Storage, current.Storage, current.Name)
+	}
+
+	return nil
+}
+
+// modifyPackPlan validates an in place pack change against the packs of the DBaaS type
+func (r *dbaasResource) modifyPackPlan(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() || r.client == nil {
+		return diags
+	}
+
+	var planPack, statePack, planType, stateType types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("pack_name"), &planPack)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("pack_name"), &statePack)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &planType)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+	if diags.HasError() || planPack.IsUnknown() || planPack.Equal(statePack) {
+		return diags
+	}
+
+	// Changing the type replaces the DBaaS, the pack is then chosen at creation
+	if !planType.Equal(stateType) {
+		return diags
+	}
+
+	next, err := r.client.DBaas.FindPack(stateType.ValueString(), planPack.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("pack_name"),
+			"Invalid DBaaS Pack",
+			fmt.Sprintf("pack %s does not exist for %s databases: %s", planPack.ValueString(), stateType.ValueString(), err),
+		)
+		return diags
+	}
+
+	current, err := r.client.DBaas.FindPack(stateType.ValueString(), statePack.ValueString())
+	if err != nil {
+		// The current pack may have been retired, let the API decide
+		return diags
+	}
+
+	if err := validatePackChange(current, next); err != nil {
+		diags.AddAttributeError(
+			path.Root("pack_name"),
+			"Invalid DBaaS Pack",
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// waitUntilResized polls until the DBaaS runs the given pack and is ready again
+func (r *dbaasResource) waitUntilResized(ctx context.Context, obj *dbaas.DBaaS, packId int64) (*dbaas.DBaaS, error) {
+	ctx, cancel := context.WithTimeout(ctx, resizeTimeout)
+	defer cancel()
+
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-t.C:
+			found, err := r.client.DBaas.GetDBaaS(obj.Project.PublicCloudId, obj.Project.ProjectId, obj.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			if found.Status == "ready" && found.Pack != nil && found.Pack.Id == packId {
+				return found, nil
+			}
+		}
+	}
+}
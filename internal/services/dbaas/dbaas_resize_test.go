@@ -0,0 +1,89 @@
+package dbaas
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("validatePackChange",
+	func(next *dbaas.DBaaSPack, matcher OmegaMatcher) {
+		current := &dbaas.DBaaSPack{Name: "essential-2", Type: "mysql", Storage: 40}
+		Expect(validatePackChange(current, next)).To(matcher)
+	},
+	Entry("larger", &dbaas.DBaaSPack{Name: "business-1", Type: "mysql", Storage: 80}, Succeed()),
+	Entry("same_storage", &dbaas.DBaaSPack{Name: "business-0", Type: "mysql", Storage: 40}, Succeed()),
+	Entry("smaller", &dbaas.DBaaSPack{Name: "essential-1", Type: "mysql", Storage: 20}, MatchError(ContainSubstring("storage cannot be shrunk"))),
+	Entry("other_type", &dbaas.DBaaSPack{Name: "pg-essential-2", Type: "postgresql", Storage: 40}, MatchError(ContainSubstring("is for postgresql databases"))),
+	Entry("type_not_given", &dbaas.DBaaSPack{Name: "essential-3", Storage: 60}, Succeed()),
+)
+
+func TestDbaasResource_Resize(t *testing.T) {
+	var id string
+
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas.resize": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_pack_small.tf"),
+					Check: resource.TestCheckResourceAttrWith("infomaniak_dbaas.db", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				},
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_pack_large.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas.db", "pack_name", "essential-2"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas.db", "status", "ready"),
+						resource.TestCheckResourceAttrWith("infomaniak_dbaas.db", "id", func(value string) error {
+							if value != id {
+								return fmt.Errorf("the DBaaS was replaced instead of resized")
+							}
+							return nil
+						}),
+					),
+				},
+			},
+		},
+		"resource.dbaas.shrink": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_pack_large.tf"),
+				},
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_pack_small.tf"),
+					ExpectError: regexp.MustCompile(`storage cannot be shrunk`),
+				},
+			},
+		},
+		"resource.dbaas.unknown_pack": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_pack_small.tf"),
+				},
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_pack_unknown.tf"),
+					ExpectError: regexp.MustCompile(`Invalid DBaaS Pack`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
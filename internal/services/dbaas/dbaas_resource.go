@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"reflect"
 	"strconv"
 	"strings"
 	"terraform-provider-infomaniak/internal/apis"
@@ -24,10 +23,12 @@ import (
 )
 
 var (
-	_ resource.Resource                 = &dbaasResource{}
-	_ resource.ResourceWithConfigure    = &dbaasResource{}
-	_ resource.ResourceWithImportState  = &dbaasResource{}
-	_ resource.ResourceWithUpgradeState = &dbaasResource{}
+	_ resource.Resource                   = &dbaasResource{}
+	_ resource.ResourceWithConfigure      = &dbaasResource{}
+	_ resource.ResourceWithImportState    = &dbaasResource{}
+	_ resource.ResourceWithUpgradeState   = &dbaasResource{}
+	_ resource.ResourceWithModifyPlan     = &dbaasResource{}
+	_ resource.ResourceWithValidateConfig = &dbaasResource{}
 )
 
 func NewDBaasResource() resource.Resource {
@@ -35,7 +36,8 @@ func NewDBaasResource() resource.Resource {
 }
 
 type dbaasResource struct {
-	client *apis.Client
+	client      *apis.Client
+	defaultTags map[string]string
 }
 
 type DBaasModel struct {
@@ -56,10 +58,32 @@ type DBaasModel struct {
 	Password types.String `tfsdk:"password"`
 	Ca       types.String `tfsdk:"ca"`
 
+	Uri         types.String `tfsdk:"uri"`
+	JdbcUrl     types.String `tfsdk:"jdbc_url"`
+	Database    types.String `tfsdk:"database"`
+	TlsMode     types.String `tfsdk:"tls_mode"`
+	CaExpiresAt types.String `tfsdk:"ca_expires_at"`
+
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+	RotatePassword    types.String `tfsdk:"rotate_password"`
+
+	BackupBeforeUpgrade types.Bool `tfsdk:"backup_before_upgrade"`
+
 	AllowedCIDRs types.List `tfsdk:"allowed_cidrs"`
 
 	Configuration          types.Dynamic `tfsdk:"configuration"`
 	EffectiveConfiguration types.Dynamic `tfsdk:"effective_configuration"`
+
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	Tags    types.Map `tfsdk:"tags"`
+	TagsAll types.Map `tfsdk:"tags_all"`
+
+	Source *DBaasSourceModel `tfsdk:"source"`
+
+	Timeouts *utils.Timeouts `tfsdk:"timeouts"`
 }
 
 func (r *dbaasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,7 +107,46 @@ func (r *dbaasResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
+	defaultTags, err := provider.GetDefaultTags(ctx, req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
 	r.client = client
+	r.defaultTags = defaultTags
+}
+
+// ModifyPlan shows the effective tags, merged with the provider default_tags, in the plan
+func (r *dbaasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := utils.MergeTags(ctx, r.defaultTags, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+	resp.Diagnostics.Append(r.modifyPackPlan(ctx, req)...)
+	resp.Diagnostics.Append(r.modifyVersionPlan(ctx, req)...)
+	resp.Diagnostics.Append(r.modifyConfigurationPlan(ctx, req)...)
+	resp.Diagnostics.Append(r.modifySourcePlan(ctx, req)...)
+}
+
+func (r *dbaasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateSourceConfig(ctx, req.Config)...)
 }
 
 func (r *dbaasResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -126,8 +189,26 @@ func (r *dbaasResource) Create(ctx context.Context, req resource.CreateRequest,
 		PackId:  chosenPack.Id,
 	}
 
-	// CreateDBaas API call logic
-	createInfos, err := r.client.DBaas.CreateDBaaS(input)
+	tags, diags := utils.TagsToApi(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = tags
+
+	// CreateDBaas API call logic, a DBaaS with a source is created by restoring the source
+	var createInfos *dbaas.DBaaSCreateInfo
+	if data.Source != nil {
+		createInfos, err = r.createFromSource(ctx, data.Source, input)
+	} else {
+		createInfos, err = r.client.DBaas.CreateDBaaS(input)
+	}
+
+	// Keep track of the new service even if the restore failed afterwards
+	if createInfos != nil {
+		data.Id = types.Int64Value(createInfos.Id)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error when creating DBaaS",
@@ -136,9 +217,6 @@ func (r *dbaasResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	data.Id = types.Int64Value(createInfos.Id)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
 	dbaasObject, err := r.waitUntilActive(ctx, input, createInfos.Id)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -219,7 +297,15 @@ func (r *dbaasResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	data.EffectiveConfiguration = newEffectiveConfig
 	data.fill(dbaasObject)
-	data.Password = types.StringValue(createInfos.RootPassword)
+	data.fillTags(dbaasObject.Tags, r.defaultTags)
+
+	password, diags := r.updateAdminPassword(ctx, req.Config, data, nil, types.StringValue(createInfos.RootPassword))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Password = password
+	data.fillConnectionDetails(dbaasObject)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -292,18 +378,15 @@ func (r *dbaasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	shouldUpdateConfiguration, diags := hasObjectChanged(state.Configuration, newConfig)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	if shouldUpdateConfiguration {
+	if hasObjectChanged(state.Configuration, newConfig) {
 		state.Configuration = newConfig
 	}
 
 	state.EffectiveConfiguration = newEffectiveConfig
 
 	state.fill(dbaasObject)
+	state.fillConnectionDetails(dbaasObject)
+	state.fillTags(dbaasObject.Tags, r.defaultTags)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -321,7 +404,8 @@ func (r *dbaasResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	chosenPackState, err := r.getPackId(state, &resp.Diagnostics)
+	// A pack change is applied in place as a resize
+	chosenPack, err := r.getPackId(data, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -334,12 +418,19 @@ func (r *dbaasResource) Update(ctx context.Context, req resource.UpdateRequest,
 		},
 		Id:      state.Id.ValueInt64(),
 		Name:    data.Name.ValueString(),
-		PackId:  chosenPackState.Id,
+		PackId:  chosenPack.Id,
 		Region:  state.Region.ValueString(),
 		Version: state.Version.ValueString(),
 		Type:    state.Type.ValueString(),
 	}
 
+	tags, diags := utils.TagsToApi(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = tags
+
 	_, err = r.client.DBaas.UpdateDBaaS(input)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -349,7 +440,12 @@ func (r *dbaasResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	dbaasObject, err := r.waitUntilActive(ctx, input, input.Id)
+	var dbaasObject *dbaas.DBaaS
+	if data.PackName.Equal(state.PackName) {
+		dbaasObject, err = r.waitUntilActive(ctx, input, input.Id)
+	} else {
+		dbaasObject, err = r.waitUntilResized(ctx, input, chosenPack.Id)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error when getting DBaaS",
@@ -362,6 +458,18 @@ func (r *dbaasResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// A version change is applied in place as an upgrade
+	if !data.Version.Equal(state.Version) {
+		dbaasObject, err = r.upgrade(ctx, dbaasObject, data.Version.ValueString(), data.BackupBeforeUpgrade.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error when upgrading DBaaS",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	cidrs := make([]string, 0, len(data.AllowedCIDRs.Elements()))
 	resp.Diagnostics.Append(data.AllowedCIDRs.ElementsAs(ctx, &cidrs, false)...)
 	allowedCIDRs := dbaas.AllowedCIDRs{
@@ -427,8 +535,22 @@ func (r *dbaasResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	password, diags := r.updateAdminPassword(ctx, req.Config, data, &state, state.Password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Password = password
+	state.PasswordWoVersion = data.PasswordWoVersion
+	state.RotatePassword = data.RotatePassword
+	state.BackupBeforeUpgrade = data.BackupBeforeUpgrade
+
 	state.EffectiveConfiguration = newEffectiveConfig
+	state.Tags = data.Tags
+	state.Source = data.Source
 	state.fill(dbaasObject)
+	state.fillConnectionDetails(dbaasObject)
+	state.fillTags(dbaasObject.Tags, r.defaultTags)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -457,6 +579,21 @@ func (r *dbaasResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		)
 		return
 	}
+
+	err = utils.WaitUntilDeleted(ctx, data.Timeouts.DeleteTimeout(), func() (bool, error) {
+		found, err := r.client.DBaas.GetDBaaS(data.PublicCloudId.ValueInt64(), data.PublicCloudProjectId.ValueInt64(), data.Id.ValueInt64())
+		if err != nil {
+			return false, err
+		}
+		return found.Status == "deleted", nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when waiting for DBaaS to be deleted",
+			err.Error(),
+		)
+		return
+	}
 }
 
 func (r *dbaasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -513,6 +650,8 @@ func (model *DBaasModel) fill(dbaas *dbaas.DBaaS) {
 	model.Version = types.StringValue(dbaas.Version)
 	model.Name = types.StringValue(dbaas.Name)
 	model.PackName = types.StringValue(dbaas.Pack.Name)
+	model.Status = types.StringValue(dbaas.Status)
+	model.CreatedAt = utils.TimestampValue(dbaas.CreatedAt)
 
 	if dbaas.Connection != nil {
 		model.Host = types.StringValue(dbaas.Connection.Host)
@@ -520,12 +659,27 @@ func (model *DBaasModel) fill(dbaas *dbaas.DBaaS) {
 		model.User = types.StringValue(dbaas.Connection.User)
 		model.Ca = types.StringValue(dbaas.Connection.Ca)
 
-		if model.Password == types.StringNull() || model.Password == types.StringUnknown() {
+		// A write-only admin password is never stored
+		if (model.Password.IsNull() && model.PasswordWoVersion.IsNull()) || model.Password.IsUnknown() {
 			model.Password = types.StringValue(dbaas.Connection.Password)
 		}
 	}
 }
 
+// fillConnectionDetails derives the connection details once the admin password is known
+func (model *DBaasModel) fillConnectionDetails(dbaas *dbaas.DBaaS) {
+	details := newConnectionDetails(dbaas.Type, dbaas.Connection, model.Password)
+	model.Uri = details.Uri
+	model.JdbcUrl = details.JdbcUrl
+	model.Database = details.DefaultDatabase
+	model.TlsMode = details.TlsMode
+	model.CaExpiresAt = details.CaExpiresAt
+}
+
+func (model *DBaasModel) fillTags(apiTags map[string]string, defaultTags map[string]string) {
+	model.Tags, model.TagsAll = utils.TagsFromApi(apiTags, defaultTags, model.Tags)
+}
+
 func refreshEffectiveConfiguration(apiClient dbaas.Api, publicCloudId, publicCloudProjectId, id int64) (types.Dynamic, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	effectiveSettings, err := apiClient.GetConfiguration(
@@ -553,22 +707,10 @@ func refreshEffectiveConfiguration(apiClient dbaas.Api, publicCloudId, publicClo
 	return dynamicObj, diags
 }
 
-// hasObjectChanged convert the state configuration and newly generated configuration
-// It then compares equivalent values ("100" is equal to 100) and tells if we need to update the state
-func hasObjectChanged(stateConfig, newConfig types.Dynamic) (bool, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	newConfigMap, d := utils.ConvertDynamicObjectToMapAny(newConfig)
-	diags.Append(d...)
-
-	newConfigConverted := utils.ConvertIntsToStrings(newConfigMap)
-
-	stateConfigMap, d := utils.ConvertDynamicObjectToMapAny(stateConfig)
-	diags.Append(d...)
-
-	stateConfigConverted := utils.ConvertIntsToStrings(stateConfigMap)
-
-	return !reflect.DeepEqual(newConfigConverted, stateConfigConverted), diags
+// hasObjectChanged compares the state configuration with the newly generated configuration
+// Equivalent values ("100" is equal to 100, nested objects included) do not require to update the state
+func hasObjectChanged(stateConfig, newConfig types.Dynamic) bool {
+	return !utils.SemanticallyEqual(stateConfig, newConfig)
 }
 
 func (r *dbaasResource) waitUntilActive(ctx context.Context, dbaas *dbaas.DBaaS, id int64) (*dbaas.DBaaS, error) {
@@ -2,11 +2,16 @@ package dbaas
 
 import (
 	"terraform-provider-infomaniak/internal/dynamic"
+	"terraform-provider-infomaniak/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -40,10 +45,7 @@ func getDbaasResourceSchema() schema.Schema {
 			},
 			"pack_name": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The name of the pack associated to the DBaaS project",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				MarkdownDescription: "The name of the pack associated to the DBaaS project, changing it resizes the DBaaS in place",
 			},
 			"type": schema.StringAttribute{
 				Required:            true,
@@ -54,10 +56,11 @@ func getDbaasResourceSchema() schema.Schema {
 			},
 			"version": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The version of database associated with the DBaaS being installed",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				MarkdownDescription: "The version of database associated with the DBaaS being installed. A newer version upgrades the DBaaS in place",
+			},
+			"backup_before_upgrade": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether a backup is taken before upgrading the DBaaS to a newer version",
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
@@ -91,10 +94,56 @@ func getDbaasResourceSchema() schema.Schema {
 				Sensitive:           true,
 				MarkdownDescription: "The password to access this database.",
 			},
+			"password_wo": schema.StringAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				MarkdownDescription: "The admin password, never stored in the state. Change `password_wo_version` to set it again",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(12),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Any change of this value resets the admin password to `password_wo`",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"rotate_password": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Any change of this value resets the admin password to a newly generated one",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+			},
 			"ca": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The Database CA Certificate",
 			},
+			"uri": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The URI to connect to the database, with the admin password unless it is write-only.",
+			},
+			"jdbc_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The JDBC URL to connect to the database, with the admin password unless it is write-only, only set for engines with a JDBC driver.",
+			},
+			"database": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The database connected to by default, e.g. `postgres` for PostgreSQL.",
+			},
+			"tls_mode": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The TLS mode set in `uri` and `jdbc_url`, e.g. `VERIFY_IDENTITY` for MySQL.",
+			},
+			"ca_expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The expiration date of the Database CA Certificate, in RFC 3339 format.",
+			},
 			"allowed_cidrs": schema.ListAttribute{
 				Required:            true,
 				ElementType:         types.StringType,
@@ -107,6 +156,28 @@ func getDbaasResourceSchema() schema.Schema {
 				Computed:            true,
 				MarkdownDescription: "DbaaS kubernetes name",
 			},
+			"tags": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the DBaaS, they take precedence over the provider `default_tags`",
+			},
+			"tags_all": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "All the tags of the DBaaS, including the provider `default_tags`",
+			},
+			"timeouts": utils.TimeoutsAttribute(),
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the DBaaS, `ready` once the database is available",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The creation date of the DBaaS, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"configuration": schema.DynamicAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Dynamic{
@@ -119,6 +190,34 @@ func getDbaasResourceSchema() schema.Schema {
 			"effective_configuration": schema.DynamicAttribute{
 				Computed: true,
 			},
+			"source": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Creates the DBaaS by restoring a backup or a point in time of another DBaaS of the same project",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(
+						sourceRequiresReplace,
+						"Changing the source of a DBaaS created from a source requires a replacement",
+						"Changing the source of a DBaaS created from a source requires a replacement",
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"dbaas_id": schema.Int64Attribute{
+						Required:            true,
+						MarkdownDescription: "The id of the DBaaS to restore",
+					},
+					"backup_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The id of the backup to restore",
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("point_in_time")),
+						},
+					},
+					"point_in_time": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The instant to restore, in RFC 3339 format. Point in time recovery must be enabled on the backup schedule of the source",
+					},
+				},
+			},
 		},
 		MarkdownDescription: "The dbaas resource allows the user to manage a dbaas project",
 	}
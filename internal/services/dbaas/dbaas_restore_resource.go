@@ -0,0 +1,367 @@
+package dbaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/apis/helpers"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &dbaasRestoreResource{}
+	_ resource.ResourceWithConfigure      = &dbaasRestoreResource{}
+	_ resource.ResourceWithImportState    = &dbaasRestoreResource{}
+	_ resource.ResourceWithValidateConfig = &dbaasRestoreResource{}
+)
+
+// restoreTimeout bounds how long Create waits for a restore to be completed
+const restoreTimeout = 2 * time.Hour
+
+func NewDBaasRestoreResource() resource.Resource {
+	return &dbaasRestoreResource{}
+}
+
+type dbaasRestoreResource struct {
+	client *apis.Client
+}
+
+type DBaasRestoreModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+	DbaasId              types.Int64 `tfsdk:"dbaas_id"`
+
+	BackupId    types.String `tfsdk:"backup_id"`
+	PointInTime types.String `tfsdk:"point_in_time"`
+	InPlace     types.Bool   `tfsdk:"in_place"`
+
+	Id        types.String `tfsdk:"id"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	NewDbaasId              types.Int64  `tfsdk:"new_dbaas_id"`
+	NewKubernetesIdentifier types.String `tfsdk:"new_kube_identifier"`
+	Host                    types.String `tfsdk:"host"`
+	Port                    types.String `tfsdk:"port"`
+	User                    types.String `tfsdk:"user"`
+	Password                types.String `tfsdk:"password"`
+	Ca                      types.String `tfsdk:"ca"`
+}
+
+func (model *DBaasRestoreModel) fill(restore *dbaas.DBaaSRestore) {
+	model.Id = types.StringValue(restore.Id)
+	model.Status = types.StringValue(restore.Status)
+	model.CreatedAt = utils.TimestampValue(restore.CreatedAt)
+	model.InPlace = types.BoolValue(restore.InPlace)
+	if restore.BackupSource != "" && restore.PointInTime == 0 {
+		model.BackupId = types.StringValue(restore.BackupSource)
+	}
+	// Keep the configured representation of the timestamp when it is the same instant
+	if restore.PointInTime != 0 {
+		configured, err := parsePointInTime(model.PointInTime.ValueString())
+		if err != nil || uint64(configured.Unix()) != restore.PointInTime {
+			model.PointInTime = utils.TimestampValue(restore.PointInTime)
+		}
+	}
+}
+
+// fillNewService sets the identifiers and credentials of the service the backup was restored into
+func (model *DBaasRestoreModel) fillNewService(obj *dbaas.DBaaS) {
+	model.NewDbaasId = types.Int64Value(obj.Id)
+	model.NewKubernetesIdentifier = types.StringValue(obj.KubernetesIdentifier)
+	if obj.Connection != nil {
+		model.Host = types.StringValue(obj.Connection.Host)
+		model.Port = types.StringValue(obj.Connection.Port)
+		model.User = types.StringValue(obj.Connection.User)
+		model.Ca = types.StringValue(obj.Connection.Ca)
+		// The root password is only known at creation, keep it when the API hides it
+		if model.Password.IsNull() || model.Password.IsUnknown() || obj.Connection.Password != "" {
+			model.Password = types.StringValue(obj.Connection.Password)
+		}
+	}
+}
+
+// clearNewService nulls the new service attributes of an in place restore
+func (model *DBaasRestoreModel) clearNewService() {
+	model.NewDbaasId = types.Int64Null()
+	model.NewKubernetesIdentifier = types.StringNull()
+	model.Host = types.StringNull()
+	model.Port = types.StringNull()
+	model.User = types.StringNull()
+	model.Password = types.StringNull()
+	model.Ca = types.StringNull()
+}
+
+// parsePointInTime parses an RFC 3339 timestamp that must not be in the future
+func parsePointInTime(value string) (time.Time, error) {
+	pointInTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a valid RFC 3339 timestamp, e.g. 2025-01-31T12:00:00Z", value)
+	}
+	if pointInTime.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("%q is in the future", value)
+	}
+
+	return pointInTime, nil
+}
+
+func (r *dbaasRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_restore"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *dbaasRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dbaasRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = getDbaasRestoreResourceSchema()
+}
+
+func (r *dbaasRestoreResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DBaasRestoreModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PointInTime.IsNull() || data.PointInTime.IsUnknown() {
+		return
+	}
+
+	if _, err := parsePointInTime(data.PointInTime.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("point_in_time"),
+			"Invalid Point In Time",
+			err.Error(),
+		)
+	}
+}
+
+func (r *dbaasRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DBaasRestoreModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &dbaas.DBaaSRestore{
+		BackupSource: data.BackupId.ValueString(),
+		InPlace:      data.InPlace.ValueBool(),
+	}
+	if !data.PointInTime.IsNull() {
+		pointInTime, err := parsePointInTime(data.PointInTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("point_in_time"),
+				"Invalid Point In Time",
+				err.Error(),
+			)
+			return
+		}
+		input.PointInTime = uint64(pointInTime.Unix())
+	}
+
+	restore, err := r.client.DBaas.CreateRestore(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.DbaasId.ValueInt64(),
+		input,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when creating Restore",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the restore before waiting for it. If waiting fails, it stays in the state as tainted:
+	// it can still be read and deleted, but the next apply replaces it and starts another restore.
+	data.Id = types.StringValue(restore.Id)
+	data.Status = types.StringNull()
+	data.CreatedAt = types.StringNull()
+	data.clearNewService()
+	// The root password of the new service is only returned now
+	if restore.NewService != nil {
+		data.NewDbaasId = types.Int64Value(restore.NewService.Id)
+		data.NewKubernetesIdentifier = types.StringValue(restore.NewService.KubeIdentifier)
+		data.Password = types.StringValue(restore.NewService.RootPassword)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	ctx, cancel := context.WithTimeout(ctx, restoreTimeout)
+	defer cancel()
+
+	restore, err = waitUntilRestored(
+		ctx,
+		r.client.DBaas,
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.DbaasId.ValueInt64(),
+		restore.Id,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when waiting for Restore to be completed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.fill(restore)
+	resp.Diagnostics.Append(r.refreshNewService(ctx, &data, restore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// waitUntilRestored polls the restore until the API reports it completed
+func waitUntilRestored(ctx context.Context, client dbaas.Api, publicCloudId, publicCloudProjectId, dbaasId int64, restoreId string) (*dbaas.DBaaSRestore, error) {
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
+	for {
+		restore, err := client.GetRestore(publicCloudId, publicCloudProjectId, dbaasId, restoreId)
+		if err != nil {
+			return nil, err
+		}
+
+		switch restore.Status {
+		case "completed":
+			return restore, nil
+		case "failed", "error":
+			return nil, fmt.Errorf("restore %s ended with status %s", restore.Id, restore.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// refreshNewService reads the connection information of the service the restore created, if any
+func (r *dbaasRestoreResource) refreshNewService(ctx context.Context, data *DBaasRestoreModel, restore *dbaas.DBaaSRestore) (diags diag.Diagnostics) {
+	if restore.NewService == nil {
+		data.clearNewService()
+		return
+	}
+
+	obj, err := r.client.DBaas.GetDBaaS(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		restore.NewService.Id,
+	)
+	if err != nil {
+		diags.AddError(
+			"Error when getting restored DBaaS",
+			err.Error(),
+		)
+		return
+	}
+
+	data.fillNewService(obj)
+	return
+}
+
+func (r *dbaasRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DBaasRestoreModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restore, err := r.client.DBaas.GetRestore(
+		state.PublicCloudId.ValueInt64(),
+		state.PublicCloudProjectId.ValueInt64(),
+		state.DbaasId.ValueInt64(),
+		state.Id.ValueString(),
+	)
+	if errors.Is(err, helpers.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when getting Restore",
+			err.Error(),
+		)
+		return
+	}
+
+	state.fill(restore)
+	resp.Diagnostics.Append(r.refreshNewService(ctx, &state, restore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dbaasRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to update in place
+	var data DBaasRestoreModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbaasRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A restore cannot be undone and the service it created is left running,
+	// removing the resource only drops it from the state
+}
+
+func (r *dbaasRestoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids, err := parseBackupRestoreImport(req)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_id"), ids.PublicCloudId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_project_id"), ids.PublicCloudProjectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dbaas_id"), ids.DbaasId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids.Id)...)
+}
@@ -0,0 +1,109 @@
+package dbaas
+
+import (
+	"regexp"
+	mockDBaas "terraform-provider-infomaniak/internal/apis/dbaas/mock"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("parsePointInTime",
+	func(value string, matcher OmegaMatcher) {
+		_, err := parsePointInTime(value)
+		Expect(err).To(matcher)
+	},
+	Entry("utc", "2025-01-31T12:00:00Z", Succeed()),
+	Entry("offset", "2025-01-31T12:00:00+01:00", Succeed()),
+	Entry("no_zone", "2025-01-31T12:00:00", MatchError(ContainSubstring("not a valid RFC 3339 timestamp"))),
+	Entry("date_only", "2025-01-31", MatchError(ContainSubstring("not a valid RFC 3339 timestamp"))),
+	Entry("future", time.Now().Add(time.Hour).Format(time.RFC3339), MatchError(ContainSubstring("is in the future"))),
+)
+
+func TestDbaasRestoreResource_Schema(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas_restore.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_restore_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas_restore.restore", "status", "completed"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_restore.restore", "in_place", "false"),
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_restore.restore", "new_dbaas_id"),
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_restore.restore", "host"),
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_restore.restore", "password"),
+					),
+				},
+			},
+		},
+		"resource.dbaas_restore.wait_failed": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						mockDBaas.RestoreStatus = "failed"
+					},
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_restore_good.tf"),
+					ExpectError: regexp.MustCompile(`ended with status failed`),
+				},
+				{
+					// The restore is kept in the state with its ids, so it can still be read
+					PreConfig: func() {
+						mockDBaas.RestoreStatus = "completed"
+					},
+					RefreshState: true,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_restore.restore", "id"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_restore.restore", "public_cloud_id", "42"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_restore.restore", "public_cloud_project_id", "54"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_restore.restore", "status", "failed"),
+					),
+				},
+			},
+		},
+		"resource.dbaas_restore.point_in_time": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_restore_point_in_time.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas_restore.restore", "status", "completed"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_restore.restore", "point_in_time", "2025-01-31T12:00:00Z"),
+						resource.TestCheckNoResourceAttr("infomaniak_dbaas_restore.restore", "new_dbaas_id"),
+					),
+				},
+			},
+		},
+		"resource.dbaas_restore.backup_and_point_in_time": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_restore_backup_and_point_in_time.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		},
+		"resource.dbaas_restore.invalid_point_in_time": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_restore_invalid_point_in_time.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Point In Time`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
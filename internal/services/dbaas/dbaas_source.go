@@ -0,0 +1,127 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DBaasSourceModel struct {
+	DbaasId     types.Int64  `tfsdk:"dbaas_id"`
+	BackupId    types.String `tfsdk:"backup_id"`
+	PointInTime types.String `tfsdk:"point_in_time"`
+}
+
+// sourceRequiresReplace replaces the DBaaS only when it switches to another source,
+// removing it does not restore anything and adding it is rejected by modifySourcePlan
+func sourceRequiresReplace(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsNull()
+}
+
+// modifySourcePlan rejects adding a source to an existing DBaaS, it would not restore anything
+func (r *dbaasResource) modifySourcePlan(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var planSource, stateSource types.Object
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("source"), &planSource)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("source"), &stateSource)...)
+	if diags.HasError() || planSource.IsNull() || !stateSource.IsNull() {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("source"),
+		"Invalid DBaaS Source",
+		"source can only be set when creating a DBaaS, adding it to an existing DBaaS does not restore anything: remove it or replace the DBaaS, e.g. with terraform apply -replace",
+	)
+	return diags
+}
+
+// validateSourceConfig checks the point in time of the source, if any
+func validateSourceConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var source *DBaasSourceModel
+	diags := config.GetAttribute(ctx, path.Root("source"), &source)
+	if diags.HasError() || source == nil {
+		return diags
+	}
+
+	if source.PointInTime.IsNull() || source.PointInTime.IsUnknown() {
+		return diags
+	}
+
+	if _, err := parsePointInTime(source.PointInTime.ValueString()); err != nil {
+		diags.AddAttributeError(
+			path.Root("source").AtName("point_in_time"),
+			"Invalid Point In Time",
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// checkSourceCompatibility ensures the source can be restored into a service of the given type and version
+func checkSourceCompatibility(source *dbaas.DBaaS, input *dbaas.DBaaS) error {
+	if source.Type != input.Type {
+		return fmt.Errorf("source dbaas %d is a %s database, it cannot be restored into a %s database", source.Id, source.Type, input.Type)
+	}
+	if source.Version != input.Version {
+		return fmt.Errorf("source dbaas %d runs version %s, it cannot be restored into version %s", source.Id, source.Version, input.Version)
+	}
+
+	return nil
+}
+
+// createFromSource creates the DBaaS by restoring the source into a new service and waits for the restore to complete
+func (r *dbaasResource) createFromSource(ctx context.Context, source *DBaasSourceModel, input *dbaas.DBaaS) (*dbaas.DBaaSCreateInfo, error) {
+	publicCloudId := input.Project.PublicCloudId
+	publicCloudProjectId := input.Project.ProjectId
+	sourceId := source.DbaasId.ValueInt64()
+
+	sourceObject, err := r.client.DBaas.GetDBaaS(publicCloudId, publicCloudProjectId, sourceId)
+	if err != nil {
+		return nil, fmt.Errorf("could not get source dbaas %d: %w", sourceId, err)
+	}
+	if err := checkSourceCompatibility(sourceObject, input); err != nil {
+		return nil, err
+	}
+
+	restoreInput := &dbaas.DBaaSRestore{
+		BackupSource: source.BackupId.ValueString(),
+		Target:       input,
+	}
+	if !source.PointInTime.IsNull() {
+		pointInTime, err := parsePointInTime(source.PointInTime.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		restoreInput.PointInTime = uint64(pointInTime.Unix())
+	}
+
+	restore, err := r.client.DBaas.CreateRestore(publicCloudId, publicCloudProjectId, sourceId, restoreInput)
+	if err != nil {
+		return nil, err
+	}
+	if restore.NewService == nil {
+		return nil, fmt.Errorf("restore %s did not create a new service", restore.Id)
+	}
+	// The root password is only returned when the restore is created
+	createInfos := restore.NewService
+
+	ctx, cancel := context.WithTimeout(ctx, restoreTimeout)
+	defer cancel()
+
+	_, err = waitUntilRestored(ctx, r.client.DBaas, publicCloudId, publicCloudProjectId, sourceId, restore.Id)
+	return createInfos, err
+}
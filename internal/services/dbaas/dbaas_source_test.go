@@ -0,0 +1,96 @@
+package dbaas
+
+import (
+	"regexp"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("checkSourceCompatibility",
+	func(input *dbaas.DBaaS, matcher OmegaMatcher) {
+		source := &dbaas.DBaaS{Id: 1, Type: "mysql", Version: "8.0"}
+		Expect(checkSourceCompatibility(source, input)).To(matcher)
+	},
+	Entry("same_type_and_version", &dbaas.DBaaS{Type: "mysql", Version: "8.0"}, Succeed()),
+	Entry("other_type", &dbaas.DBaaS{Type: "postgresql", Version: "8.0"}, MatchError(ContainSubstring("cannot be restored into a postgresql database"))),
+	Entry("other_version", &dbaas.DBaaS{Type: "mysql", Version: "8.4"}, MatchError(ContainSubstring("cannot be restored into version 8.4"))),
+)
+
+func TestDbaasResource_Source(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas.source_backup": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_source_backup.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas.staging", "name", "staging"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas.staging", "pack_name", "essential-2"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas.staging", "status", "ready"),
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas.staging", "password"),
+						resource.TestCheckResourceAttrPair(
+							"infomaniak_dbaas.staging", "source.backup_id",
+							"infomaniak_dbaas_backup.backup", "id",
+						),
+					),
+				},
+			},
+		},
+		"resource.dbaas.source_point_in_time": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_source_point_in_time.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas.staging", "status", "ready"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas.staging", "source.point_in_time", "2025-01-31T12:00:00Z"),
+					),
+				},
+			},
+		},
+		"resource.dbaas.source_added": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_source_none.tf"),
+					Check:  resource.TestCheckNoResourceAttr("infomaniak_dbaas.staging", "source"),
+				},
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_source_backup.tf"),
+					ExpectError: regexp.MustCompile(`source can only be set when creating a DBaaS`),
+				},
+			},
+		},
+		"resource.dbaas.source_backup_and_point_in_time": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_source_backup_and_point_in_time.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		},
+		"resource.dbaas.source_invalid_point_in_time": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_source_invalid_point_in_time.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Point In Time`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// upgradeTimeout bounds how long Update waits for a DBaaS to run its new version
+const upgradeTimeout = 2 * time.Hour
+
+// engineVersion holds the numeric components of a database engine version, e.g. 8.0.42
+type engineVersion []int
+
+func parseEngineVersion(value string) (engineVersion, error) {
+	parts := strings.Split(value, ".")
+	version := make(engineVersion, 0, len(parts))
+	for _, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("%q is not a valid version, expected numbers separated by dots such as 8.0", value)
+		}
+		version = append(version, number)
+	}
+
+	return version, nil
+}
+
+func (v engineVersion) String() string {
+	parts := make([]string, 0, len(v))
+	for _, number := range v {
+		parts = append(parts, strconv.Itoa(number))
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// series returns the major.minor release series of the version
+func (v engineVersion) series() engineVersion {
+	return v[:min(2, len(v))]
+}
+
+// hasPrefix tells whether the version belongs to the prefix, 8.0.42 belongs to 8.0
+func (v engineVersion) hasPrefix(prefix engineVersion) bool {
+	return len(prefix) <= len(v) && slices.Equal(v[:len(prefix)], prefix)
+}
+
+// validateVersionUpgrade checks that going from current to target is an upgrade path offered for the engine:
+// target must be an available version, newer than current, and must not skip an available release series
+func validateVersionUpgrade(dbType, current, target string, available []string) error {
+	currentVersion, err := parseEngineVersion(current)
+	if err != nil {
+		return err
+	}
+	targetVersion, err := parseEngineVersion(target)
+	if err != nil {
+		return err
+	}
+
+	if slices.Compare(targetVersion, currentVersion) < 0 {
+		return fmt.Errorf("downgrading %s from %s to %s is not supported", dbType, current, target)
+	}
+
+	availableVersions := make([]engineVersion, 0, len(available))
+	for _, value := range available {
+		version, err := parseEngineVersion(value)
+		if err != nil {
+			continue
+		}
+		availableVersions = append(availableVersions, version)
+	}
+	slices.SortFunc(availableVersions, slices.Compare)
+
+	if !slices.ContainsFunc(availableVersions, targetVersion.hasPrefix) {
+		return fmt.Errorf("version %s is not available for %s databases, available versions are: %s", target, dbType, strings.Join(available, ", "))
+	}
+
+	for _, version := range availableVersions {
+		series := version.series()
+		if slices.Compare(series, currentVersion.series()) > 0 && slices.Compare(series, targetVersion.series()) < 0 {
+			return fmt.Errorf("upgrading %s from %s to %s skips version %s, upgrade to %s first", dbType, current, target, series, series)
+		}
+	}
+
+	return nil
+}
+
+// modifyVersionPlan validates an in place version change against the versions offered for the DBaaS type
+func (r *dbaasResource) modifyVersionPlan(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() || r.client == nil {
+		return diags
+	}
+
+	var planVersion, stateVersion, planType, stateType types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &planVersion)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("version"), &stateVersion)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &planType)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+	if diags.HasError() || planVersion.IsUnknown() || planVersion.Equal(stateVersion) {
+		return diags
+	}
+
+	// Changing the type replaces the DBaaS, the version is then chosen at creation
+	if !planType.Equal(stateType) {
+		return diags
+	}
+
+	dbTypes, err := r.client.DBaas.GetDbaasTypes()
+	if err != nil {
+		diags.AddError(
+			"Error when getting DBaaS types",
+			err.Error(),
+		)
+		return diags
+	}
+
+	index := slices.IndexFunc(dbTypes, func(dbType *dbaas.DbaasType) bool {
+		return dbType.Name == stateType.ValueString()
+	})
+	if index < 0 {
+		return diags
+	}
+
+	if err := validateVersionUpgrade(stateType.ValueString(), stateVersion.ValueString(), planVersion.ValueString(), dbTypes[index].Versions); err != nil {
+		diags.AddAttributeError(
+			path.Root("version"),
+			"Invalid DBaaS Version Upgrade",
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// upgrade takes the optional pre-upgrade backup, upgrades the DBaaS and waits until it runs the new version
+func (r *dbaasResource) upgrade(ctx context.Context, obj *dbaas.DBaaS, version string, backup bool) (*dbaas.DBaaS, error) {
+	ctx, cancel := context.WithTimeout(ctx, upgradeTimeout)
+	defer cancel()
+
+	publicCloudId := obj.Project.PublicCloudId
+	publicCloudProjectId := obj.Project.ProjectId
+
+	if backup {
+		backupId, err := r.client.DBaas.CreateBackup(publicCloudId, publicCloudProjectId, obj.Id)
+		if err != nil {
+			return nil, fmt.Errorf("could not back up before upgrading: %w", err)
+		}
+		if _, err := waitUntilBackedUp(ctx, r.client.DBaas, publicCloudId, publicCloudProjectId, obj.Id, backupId); err != nil {
+			return nil, fmt.Errorf("could not back up before upgrading: %w", err)
+		}
+	}
+
+	_, err := r.client.DBaas.UpgradeDBaaS(publicCloudId, publicCloudProjectId, obj.Id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-t.C:
+			found, err := r.client.DBaas.GetDBaaS(publicCloudId, publicCloudProjectId, obj.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			if found.Status == "ready" && found.Version == version {
+				return found, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package dbaas
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("validateVersionUpgrade",
+	func(current string, target string, matcher OmegaMatcher) {
+		available := []string{"5.7", "8.0", "8.4"}
+		Expect(validateVersionUpgrade("mysql", current, target, available)).To(matcher)
+	},
+	Entry("same", "8.0", "8.0", Succeed()),
+	Entry("next_series", "8.0", "8.4", Succeed()),
+	Entry("patch_release", "8.0", "8.0.42", Succeed()),
+	Entry("from_patch", "8.0.36", "8.4", Succeed()),
+	Entry("downgrade", "8.4", "8.0", MatchError(ContainSubstring("downgrading mysql from 8.4 to 8.0 is not supported"))),
+	Entry("skip_series", "5.7", "8.4", MatchError(ContainSubstring("skips version 8.0, upgrade to 8.0 first"))),
+	Entry("not_available", "8.0", "9.1", MatchError(ContainSubstring("available versions are: 5.7, 8.0, 8.4"))),
+	Entry("invalid_target", "8.0", "latest", MatchError(ContainSubstring(`"latest" is not a valid version`))),
+	Entry("invalid_current", "8.x", "8.4", MatchError(ContainSubstring(`"8.x" is not a valid version`))),
+)
+
+func TestDbaasResource_Upgrade(t *testing.T) {
+	var id string
+
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas.upgrade": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_version_8_0.tf"),
+					Check: resource.TestCheckResourceAttrWith("infomaniak_dbaas.db", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				},
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_version_8_4.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas.db", "version", "8.4"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas.db", "status", "ready"),
+						resource.TestCheckResourceAttrWith("infomaniak_dbaas.db", "id", func(value string) error {
+							if value != id {
+								return fmt.Errorf("the DBaaS was replaced instead of upgraded")
+							}
+							return nil
+						}),
+					),
+				},
+				{
+					// The backups are read again once the upgrade is applied
+					Config: test.MustGetTestFile("schema", "resource_dbaas_version_8_4.tf"),
+					Check:  resource.TestCheckResourceAttr("data.infomaniak_dbaas_backups.backups", "backups.#", "1"),
+				},
+			},
+		},
+		"resource.dbaas.downgrade": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_version_8_4.tf"),
+				},
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_version_8_0.tf"),
+					ExpectError: regexp.MustCompile(`downgrading mysql from 8.4 to 8.0 is not supported`),
+				},
+			},
+		},
+		"resource.dbaas.skip_version": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_version_5_7.tf"),
+				},
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_version_8_4.tf"),
+					ExpectError: regexp.MustCompile(`Invalid DBaaS Version Upgrade`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package dbaas
+
+import (
+	"context"
+	"errors"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/dbaas"
+	"terraform-provider-infomaniak/internal/apis/helpers"
+	"terraform-provider-infomaniak/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &dbaasUserResource{}
+	_ resource.ResourceWithConfigure   = &dbaasUserResource{}
+	_ resource.ResourceWithImportState = &dbaasUserResource{}
+	_ resource.ResourceWithModifyPlan  = &dbaasUserResource{}
+)
+
+// generatedPasswordLength is the length of the passwords generated when no password_wo is given
+const generatedPasswordLength = 32
+
+func NewDBaasUserResource() resource.Resource {
+	return &dbaasUserResource{}
+}
+
+type dbaasUserResource struct {
+	client *apis.Client
+}
+
+type DBaasUserModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+	DbaasId              types.Int64 `tfsdk:"dbaas_id"`
+
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+
+	Password          types.String `tfsdk:"password"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+
+	Grants []DBaasGrantModel `tfsdk:"grants"`
+}
+
+type DBaasGrantModel struct {
+	Database   types.String   `tfsdk:"database"`
+	Privileges []types.String `tfsdk:"privileges"`
+}
+
+func (model *DBaasUserModel) fill(user *dbaas.DBaaSUser) {
+	model.Id = types.StringValue(user.Name)
+	model.Name = types.StringValue(user.Name)
+
+	// Keep an empty set of grants as configured
+	if len(user.Grants) == 0 && model.Grants == nil {
+		return
+	}
+
+	model.Grants = make([]DBaasGrantModel, 0, len(user.Grants))
+	for _, grant := range user.Grants {
+		privileges := make([]types.String, 0, len(grant.Privileges))
+		for _, privilege := range grant.Privileges {
+			privileges = append(privileges, types.StringValue(privilege))
+		}
+		model.Grants = append(model.Grants, DBaasGrantModel{
+			Database:   types.StringValue(grant.Database),
+			Privileges: privileges,
+		})
+	}
+}
+
+func (model *DBaasUserModel) grantsInput() []*dbaas.DBaaSGrant {
+	grants := make([]*dbaas.DBaaSGrant, 0, len(model.Grants))
+	for _, grant := range model.Grants {
+		privileges := make([]string, 0, len(grant.Privileges))
+		for _, privilege := range grant.Privileges {
+			privileges = append(privileges, privilege.ValueString())
+		}
+		grants = append(grants, &dbaas.DBaaSGrant{
+			Database:   grant.Database.ValueString(),
+			Privileges: privileges,
+		})
+	}
+
+	return grants
+}
+
+// configuredPassword returns the write-only password, only available in the configuration
+func configuredPassword(ctx context.Context, config tfsdk.Config) (types.String, diag.Diagnostics) {
+	var password types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &password)
+	return password, diags
+}
+
+func (r *dbaasUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_user"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *dbaasUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dbaasUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = getDbaasUserResourceSchema()
+}
+
+// ModifyPlan marks the stored password as unknown when the write-only password is about to be sent again
+func (r *dbaasUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planVersion, stateVersion types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("password_wo_version"), &planVersion)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_wo_version"), &stateVersion)...)
+	if resp.Diagnostics.HasError() || planVersion.Equal(stateVersion) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringUnknown())...)
+}
+
+func (r *dbaasUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DBaasUserModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	passwordWo, diags := configuredPassword(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a write-only password, one is generated and kept in the state
+	password := passwordWo.ValueString()
+	data.Password = types.StringNull()
+	if passwordWo.IsNull() {
+		generated, err := generatePassword(generatedPasswordLength)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error when generating User password",
+				err.Error(),
+			)
+			return
+		}
+		password = generated
+		data.Password = types.StringValue(generated)
+	}
+
+	input := &dbaas.DBaaSUser{
+		Name:     data.Name.ValueString(),
+		Password: password,
+		Grants:   data.grantsInput(),
+	}
+
+	ok, err := r.client.DBaas.CreateUser(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.DbaasId.ValueInt64(),
+		input,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when creating User",
+			err.Error(),
+		)
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error when creating User",
+			"CreateUser returned false but no error was provided",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(input.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbaasUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DBaasUserModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.DBaas.GetUser(
+		state.PublicCloudId.ValueInt64(),
+		state.PublicCloudProjectId.ValueInt64(),
+		state.DbaasId.ValueInt64(),
+		state.Id.ValueString(),
+	)
+	if errors.Is(err, helpers.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when getting User",
+			err.Error(),
+		)
+		return
+	}
+
+	state.fill(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dbaasUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state DBaasUserModel
+	var data DBaasUserModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &dbaas.DBaaSUser{
+		Grants: data.grantsInput(),
+	}
+
+	// The write-only password is only sent again when its version changes
+	data.Password = state.Password
+	if !data.PasswordWoVersion.Equal(state.PasswordWoVersion) {
+		passwordWo, diags := configuredPassword(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input.Password = passwordWo.ValueString()
+		data.Password = types.StringNull()
+
+		// Without a write-only password anymore, a generated one is set and kept in the state
+		if passwordWo.IsNull() {
+			generated, err := generatePassword(generatedPasswordLength)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error when generating User password",
+					err.Error(),
+				)
+				return
+			}
+			input.Password = generated
+			data.Password = types.StringValue(generated)
+		}
+	}
+
+	ok, err := r.client.DBaas.UpdateUser(
+		state.PublicCloudId.ValueInt64(),
+		state.PublicCloudProjectId.ValueInt64(),
+		state.DbaasId.ValueInt64(),
+		state.Id.ValueString(),
+		input,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when updating User",
+			err.Error(),
+		)
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error when updating User",
+			"UpdateUser returned false but no error was provided",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbaasUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DBaasUserModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DBaas.DeleteUser(
+		state.PublicCloudId.ValueInt64(),
+		state.PublicCloudProjectId.ValueInt64(),
+		state.DbaasId.ValueInt64(),
+		state.Id.ValueString(),
+	)
+	if err != nil && !errors.Is(err, helpers.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error when deleting User",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dbaasUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids, err := parseBackupRestoreImport(req)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_id"), ids.PublicCloudId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_project_id"), ids.PublicCloudProjectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dbaas_id"), ids.DbaasId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids.Id)...)
+}
@@ -0,0 +1,152 @@
+package dbaas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	mockDBaas "terraform-provider-infomaniak/internal/apis/dbaas/mock"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("generatePassword", func() {
+	It("generates distinct passwords from the alphabet", func() {
+		first, err := generatePassword(generatedPasswordLength)
+		Expect(err).NotTo(HaveOccurred())
+		second, err := generatePassword(generatedPasswordLength)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(first).To(HaveLen(generatedPasswordLength))
+		Expect(strings.Trim(first, passwordAlphabet)).To(BeEmpty(), "password %q has characters outside of the alphabet", first)
+		Expect(first).NotTo(Equal(second))
+	})
+})
+
+// importStateId builds the public_cloud_id,public_cloud_project_id,dbaas_id,id import identifier of a resource
+func importStateId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+
+		attributes := rs.Primary.Attributes
+		return fmt.Sprintf("%s,%s,%s,%s", attributes["public_cloud_id"], attributes["public_cloud_project_id"], attributes["dbaas_id"], attributes["id"]), nil
+	}
+}
+
+// checkMockedUserGrants ensures the user held by the mock has the expected number of grants
+func checkMockedUserGrants(name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ids, attributes, err := test.GetIdsFromState(s, name, "public_cloud_id", "public_cloud_project_id", "dbaas_id")
+		if err != nil {
+			return err
+		}
+
+		user, err := (&mockDBaas.Client{}).GetUser(ids[0], ids[1], ids[2], attributes["id"])
+		if err != nil {
+			return err
+		}
+		if len(user.Grants) != expected {
+			return fmt.Errorf("expected %d grants, got %d", expected, len(user.Grants))
+		}
+		return nil
+	}
+}
+
+func TestDbaasUserResource_Schema(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"resource.dbaas_user.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_user_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_dbaas_database.app", "id", "app"),
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_database.app", "character_set"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_user.app", "id", "app"),
+						resource.TestCheckResourceAttrSet("infomaniak_dbaas_user.app", "password"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_user.app", "grants.#", "1"),
+						resource.TestCheckResourceAttr("infomaniak_dbaas_user.app", "grants.0.privileges.#", "4"),
+					),
+				},
+				{
+					ResourceName:      "infomaniak_dbaas_database.app",
+					ImportState:       true,
+					ImportStateIdFunc: importStateId("infomaniak_dbaas_database.app"),
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:            "infomaniak_dbaas_user.app",
+					ImportState:             true,
+					ImportStateIdFunc:       importStateId("infomaniak_dbaas_user.app"),
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"password"},
+				},
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_user_revoked.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckNoResourceAttr("infomaniak_dbaas_user.app", "grants.#"),
+						checkMockedUserGrants("infomaniak_dbaas_user.app", 0),
+					),
+				},
+			},
+		},
+		"resource.dbaas_user.password_wo": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_user_password_wo.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckNoResourceAttr("infomaniak_dbaas_user.app", "password"),
+						resource.TestCheckNoResourceAttr("infomaniak_dbaas_user.app", "password_wo"),
+					),
+				},
+				{
+					Config: test.MustGetTestFile("schema", "resource_dbaas_user_revoked.tf"),
+					Check:  resource.TestCheckResourceAttrSet("infomaniak_dbaas_user.app", "password"),
+				},
+			},
+		},
+		"resource.dbaas_user.password_wo_without_version": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_user_password_wo_without_version.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		},
+		"resource.dbaas_user.invalid_privilege": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_user_invalid_privilege.tf"),
+					ExpectError: regexp.MustCompile(`must be an upper case SQL privilege`),
+				},
+			},
+		},
+		"resource.dbaas_user.unknown_database": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_dbaas_user_unknown_database.tf"),
+					ExpectError: regexp.MustCompile(`database missing not found`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
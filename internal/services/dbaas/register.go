@@ -5,8 +5,15 @@ import "terraform-provider-infomaniak/internal/provider/registry"
 func Register() {
 	registry.RegisterResource(NewDBaasResource)
 	registry.RegisterResource(NewDBaasBackupScheduleResource)
+	registry.RegisterResource(NewDBaasBackupResource)
+	registry.RegisterResource(NewDBaasRestoreResource)
+	registry.RegisterResource(NewDBaasDatabaseResource)
+	registry.RegisterResource(NewDBaasUserResource)
 
 	registry.RegisterDataSource(NewDBaasDataSource)
 	registry.RegisterDataSource(NewDBaasPackDataSource)
+	registry.RegisterDataSource(NewDBaasPacksDataSource)
 	registry.RegisterDataSource(NewDBaasConstsDataSource)
+	registry.RegisterDataSource(NewDBaasBackupsDataSource)
+	registry.RegisterDataSource(NewDBaasListDataSource)
 }
@@ -0,0 +1,22 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+
+data "infomaniak_dbaas" "db" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  id   = 1
+  name = "list-app"
+}
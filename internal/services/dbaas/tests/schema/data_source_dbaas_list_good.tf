@@ -0,0 +1,69 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+
+resource "infomaniak_dbaas" "app" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  name    = "list-app"
+  region  = "dc5-a"
+  type    = "mysql"
+  version = "8.0"
+  allowed_cidrs = [
+    "0.0.0.0/0"
+  ]
+  pack_name = "essential-1"
+}
+
+resource "infomaniak_dbaas" "worker" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  name    = "list-worker"
+  region  = "dc5-a"
+  type    = "mysql"
+  version = "8.0"
+  allowed_cidrs = [
+    "0.0.0.0/0"
+  ]
+  pack_name = "essential-1"
+}
+
+data "infomaniak_dbaas" "app" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  name = "list-app"
+
+  depends_on = [infomaniak_dbaas.app, infomaniak_dbaas.worker]
+}
+
+data "infomaniak_dbaas_list" "all" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  type = "mysql"
+
+  depends_on = [infomaniak_dbaas.app, infomaniak_dbaas.worker]
+}
+
+data "infomaniak_dbaas_list" "worker" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  name = "list-worker"
+
+  depends_on = [infomaniak_dbaas.app, infomaniak_dbaas.worker]
+}
@@ -0,0 +1,51 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+
+resource "infomaniak_dbaas" "app" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  name    = "list-twin"
+  region  = "dc5-a"
+  type    = "mysql"
+  version = "8.0"
+  allowed_cidrs = [
+    "0.0.0.0/0"
+  ]
+  pack_name = "essential-1"
+}
+
+resource "infomaniak_dbaas" "worker" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  name    = "list-twin"
+  region  = "dc5-a"
+  type    = "mysql"
+  version = "8.0"
+  allowed_cidrs = [
+    "0.0.0.0/0"
+  ]
+  pack_name = "essential-1"
+}
+
+data "infomaniak_dbaas" "twin" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  name = "list-twin"
+
+  depends_on = [infomaniak_dbaas.app, infomaniak_dbaas.worker]
+}
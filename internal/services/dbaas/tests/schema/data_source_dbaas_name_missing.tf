@@ -0,0 +1,21 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+
+data "infomaniak_dbaas" "db" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 77
+
+  name = "list-missing"
+}
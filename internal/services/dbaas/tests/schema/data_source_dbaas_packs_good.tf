@@ -0,0 +1,27 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+
+data "infomaniak_dbaas_packs" "large" {
+  type = "mysql"
+
+  min_cpu = 4
+  min_ram = 16
+}
+
+data "infomaniak_dbaas_packs" "none" {
+  type = "mysql"
+
+  min_cpu = 64
+}
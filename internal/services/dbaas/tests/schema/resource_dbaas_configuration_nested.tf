@@ -0,0 +1,35 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+
+resource "infomaniak_dbaas" "db" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 54
+
+  name    = "test"
+  region  = "dc5-a"
+  type    = "postgresql"
+  version = "16"
+  allowed_cidrs = [
+    "0.0.0.0/0"
+  ]
+  pack_name = "essential-1"
+
+  configuration = {
+    max_connections = 300
+    pgbouncer = {
+      pool_mode = "transaction"
+    }
+  }
+}
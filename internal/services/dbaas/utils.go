@@ -1,8 +1,10 @@
 package dbaas
 
 import (
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -44,3 +46,21 @@ func parseBackupRestoreImport(req resource.ImportStateRequest) (*ImportIds, erro
 		Id:                   id,
 	}, nil
 }
+
+// passwordAlphabet avoids characters that need escaping in connection strings and shells
+const passwordAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_.~"
+
+// generatePassword returns a random password of the given length
+func generatePassword(length int) (string, error) {
+	password := make([]byte, length)
+	size := big.NewInt(int64(len(passwordAlphabet)))
+	for i := range password {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+
+	return string(password), nil
+}
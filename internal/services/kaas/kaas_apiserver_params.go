@@ -0,0 +1,203 @@
+package kaas
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiserverFlag describes a kube-apiserver flag accepted in apiserver.params
+type apiserverFlag struct {
+	validate func(string) error
+	// since is the first Kubernetes minor version accepting the flag, 0 when every supported version does
+	since int
+}
+
+// apiserverFlags is the catalog of the kube-apiserver flags accepted by the platform, any other flag
+// is dropped by the API. The OIDC flags are left out, they are managed by the oidc block.
+var apiserverFlags = map[string]apiserverFlag{
+	"--anonymous-auth":                          {validate: validateFlagBool},
+	"--api-audiences":                           {validate: validateFlagList},
+	"--authentication-config":                   {validate: validateFlagString, since: 30},
+	"--default-not-ready-toleration-seconds":    {validate: validateFlagInt},
+	"--default-unreachable-toleration-seconds":  {validate: validateFlagInt},
+	"--disable-admission-plugins":               {validate: validateFlagList},
+	"--enable-admission-plugins":                {validate: validateFlagList},
+	"--enable-aggregator-routing":               {validate: validateFlagBool},
+	"--event-ttl":                               {validate: validateFlagDuration},
+	"--feature-gates":                           {validate: validateFlagFeatureGates},
+	"--goaway-chance":                           {validate: validateFlagGoawayChance},
+	"--max-mutating-requests-inflight":          {validate: validateFlagInt},
+	"--max-requests-inflight":                   {validate: validateFlagInt},
+	"--min-request-timeout":                     {validate: validateFlagInt},
+	"--profiling":                               {validate: validateFlagBool},
+	"--request-timeout":                         {validate: validateFlagDuration},
+	"--runtime-config":                          {validate: validateFlagKeyValues},
+	"--service-account-extend-token-expiration": {validate: validateFlagBool},
+	"--service-account-max-token-expiration":    {validate: validateFlagDuration},
+	"--service-node-port-range":                 {validate: validateFlagPortRange},
+	"--watch-cache":                             {validate: validateFlagBool},
+}
+
+// validateApiserverParam checks a key and value of apiserver.params against the flag catalog.
+// kubernetesVersion may be empty when it is not known yet, the availability of the flag is not checked then.
+func validateApiserverParam(key, value, kubernetesVersion string) error {
+	if !strings.HasPrefix(key, "--") {
+		if _, ok := apiserverFlags["--"+key]; ok {
+			return fmt.Errorf("%s should be written --%s", key, key)
+		}
+	}
+
+	flag, ok := apiserverFlags[key]
+	if !ok {
+		return fmt.Errorf("%s is not a kube-apiserver flag accepted by the platform, accepted flags are %s", key, strings.Join(slices.Sorted(maps.Keys(apiserverFlags)), ", "))
+	}
+
+	if flag.since != 0 && kubernetesVersion != "" {
+		version, err := parseKubernetesVersion(kubernetesVersion)
+		if err == nil && version.major == 1 && version.minor < flag.since {
+			return fmt.Errorf("%s requires Kubernetes 1.%d or later", key, flag.since)
+		}
+	}
+
+	if err := flag.validate(value); err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	return nil
+}
+
+func validateApiserverParamsConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	paramsPath := path.Root("apiserver").AtName("params")
+
+	var params types.Map
+	diags := config.GetAttribute(ctx, paramsPath, &params)
+	if diags.HasError() || params.IsNull() || params.IsUnknown() {
+		return diags
+	}
+
+	var kubernetesVersion types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("kubernetes_version"), &kubernetesVersion)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for key, element := range params.Elements() {
+		value, ok := element.(types.String)
+		// The OIDC flags are reported by validateOidcConfig
+		if !ok || value.IsNull() || value.IsUnknown() || isOidcParam(key) {
+			continue
+		}
+		if err := validateApiserverParam(key, value.ValueString(), kubernetesVersion.ValueString()); err != nil {
+			diags.AddAttributeError(paramsPath.AtMapKey(key), "Invalid Apiserver param", err.Error())
+		}
+	}
+
+	return diags
+}
+
+// managedApiserverParams reads back the remote value of the params keys present in managed.
+// Flags set by the platform itself are ignored, only the user managed keys are compared.
+func managedApiserverParams(managed types.Map, remote map[string]string) types.Map {
+	if managed.IsNull() || managed.IsUnknown() {
+		return managed
+	}
+
+	elements := make(map[string]attr.Value)
+	for key := range managed.Elements() {
+		if value, ok := remote[key]; ok {
+			elements[key] = types.StringValue(value)
+		}
+	}
+
+	params, _ := types.MapValue(types.StringType, elements)
+	return params
+}
+
+func validateFlagString(value string) error {
+	if value == "" {
+		return fmt.Errorf("should not be empty")
+	}
+	return nil
+}
+
+func validateFlagBool(value string) error {
+	if value != "true" && value != "false" {
+		return fmt.Errorf("should be true or false, got %q", value)
+	}
+	return nil
+}
+
+func validateFlagInt(value string) error {
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < 0 {
+		return fmt.Errorf("should be a positive integer, got %q", value)
+	}
+	return nil
+}
+
+func validateFlagDuration(value string) error {
+	if _, err := time.ParseDuration(value); err != nil {
+		return fmt.Errorf("should be a duration such as 1h30m, got %q", value)
+	}
+	return nil
+}
+
+func validateFlagGoawayChance(value string) error {
+	chance, err := strconv.ParseFloat(value, 64)
+	if err != nil || chance < 0 || chance > 0.02 {
+		return fmt.Errorf("should be a number between 0 and 0.02, got %q", value)
+	}
+	return nil
+}
+
+func validateFlagList(value string) error {
+	for item := range strings.SplitSeq(value, ",") {
+		if strings.TrimSpace(item) == "" {
+			return fmt.Errorf("should be a comma separated list without empty items, got %q", value)
+		}
+	}
+	return nil
+}
+
+func validateFlagKeyValues(value string) error {
+	for item := range strings.SplitSeq(value, ",") {
+		key, _, found := strings.Cut(item, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return fmt.Errorf("should be a comma separated list of key=value pairs, got %q", value)
+		}
+	}
+	return nil
+}
+
+func validateFlagFeatureGates(value string) error {
+	for item := range strings.SplitSeq(value, ",") {
+		key, enabled, found := strings.Cut(item, "=")
+		if !found || strings.TrimSpace(key) == "" || validateFlagBool(enabled) != nil {
+			return fmt.Errorf("should be a comma separated list of Feature=true|false pairs, got %q", value)
+		}
+	}
+	return nil
+}
+
+func validateFlagPortRange(value string) error {
+	low, high, found := strings.Cut(value, "-")
+	if !found {
+		return fmt.Errorf("should be a port range such as 30000-32767, got %q", value)
+	}
+	lowPort, lowErr := strconv.ParseUint(low, 10, 16)
+	highPort, highErr := strconv.ParseUint(high, 10, 16)
+	if lowErr != nil || highErr != nil || lowPort == 0 || lowPort > highPort {
+		return fmt.Errorf("should be a port range such as 30000-32767, got %q", value)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package kaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("validateApiserverParam",
+	func(key string, value string, version string, matcher OmegaMatcher) {
+		Expect(validateApiserverParam(key, value, version)).To(matcher)
+	},
+	Entry("bool.good", "--profiling", "false", "1.30", Succeed()),
+	Entry("bool.bad", "--profiling", "no", "1.30", MatchError(ContainSubstring("should be true or false"))),
+	Entry("int.good", "--max-requests-inflight", "800", "1.30", Succeed()),
+	Entry("int.negative", "--max-requests-inflight", "-1", "1.30", MatchError(ContainSubstring("positive integer"))),
+	Entry("duration.good", "--event-ttl", "2h", "1.30", Succeed()),
+	Entry("duration.bad", "--event-ttl", "2 hours", "1.30", MatchError(ContainSubstring("should be a duration"))),
+	Entry("list.good", "--enable-admission-plugins", "NodeRestriction,AlwaysPullImages", "1.30", Succeed()),
+	Entry("list.empty_item", "--enable-admission-plugins", "NodeRestriction,", "1.30", MatchError(ContainSubstring("without empty items"))),
+	Entry("feature_gates.good", "--feature-gates", "InPlacePodVerticalScaling=true,Foo=false", "1.30", Succeed()),
+	Entry("feature_gates.bad", "--feature-gates", "InPlacePodVerticalScaling", "1.30", MatchError(ContainSubstring("Feature=true|false"))),
+	Entry("runtime_config.good", "--runtime-config", "api/all=true", "1.30", Succeed()),
+	Entry("port_range.good", "--service-node-port-range", "30000-32767", "1.30", Succeed()),
+	Entry("port_range.reversed", "--service-node-port-range", "32767-30000", "1.30", MatchError(ContainSubstring("port range"))),
+	Entry("goaway_chance.too_high", "--goaway-chance", "0.5", "1.30", MatchError(ContainSubstring("between 0 and 0.02"))),
+	Entry("unknown", "--enable-admision-plugins", "NodeRestriction", "1.30", MatchError(ContainSubstring("is not a kube-apiserver flag accepted"))),
+	Entry("missing_dashes", "profiling", "false", "1.30", MatchError(ContainSubstring("should be written --profiling"))),
+	Entry("since.too_old", "--authentication-config", "/etc/auth.yaml", "1.29", MatchError(ContainSubstring("requires Kubernetes 1.30"))),
+	Entry("since.good", "--authentication-config", "/etc/auth.yaml", "1.31.2", Succeed()),
+	Entry("since.unknown_version", "--authentication-config", "/etc/auth.yaml", "", Succeed()),
+)
+
+var _ = Describe("managedApiserverParams", func() {
+	remote := map[string]string{
+		"--profiling":             "false",
+		"--event-ttl":             "3h",
+		"--max-requests-inflight": "400",
+	}
+
+	It("only reads back the managed params", func() {
+		managed := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"--profiling":       types.StringValue("false"),
+			"--event-ttl":       types.StringValue("2h"),
+			"--request-timeout": types.StringValue("1m"),
+		})
+
+		expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"--profiling": types.StringValue("false"),
+			"--event-ttl": types.StringValue("3h"),
+		})
+		params := managedApiserverParams(managed, remote)
+		Expect(params.Equal(expected)).To(BeTrue(), "expected %s, got %s", expected, params)
+	})
+
+	It("keeps unmanaged params null", func() {
+		params := managedApiserverParams(types.MapNull(types.StringType), remote)
+		Expect(params.IsNull()).To(BeTrue(), "got %s", params)
+	})
+})
@@ -0,0 +1,224 @@
+package kaas
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	auditPolicyApiVersion = "audit.k8s.io/v1"
+	auditPolicyKind       = "Policy"
+)
+
+var (
+	auditLevels = []string{"None", "Metadata", "Request", "RequestResponse"}
+	auditStages = []string{"RequestReceived", "ResponseStarted", "ResponseComplete", "Panic"}
+)
+
+type AuditPolicyRuleModel struct {
+	Level      types.String               `tfsdk:"level"`
+	Users      types.List                 `tfsdk:"users"`
+	Groups     types.List                 `tfsdk:"groups"`
+	Verbs      types.List                 `tfsdk:"verbs"`
+	Resources  []AuditPolicyResourceModel `tfsdk:"resources"`
+	Namespaces types.List                 `tfsdk:"namespaces"`
+	OmitStages types.List                 `tfsdk:"omit_stages"`
+}
+
+type AuditPolicyResourceModel struct {
+	Group         types.String `tfsdk:"group"`
+	Resources     types.List   `tfsdk:"resources"`
+	ResourceNames types.List   `tfsdk:"resource_names"`
+}
+
+// auditPolicy mirrors the audit.k8s.io/v1 Policy document, every field is listed so strict decoding
+// only rejects keys Kubernetes would reject as well
+type auditPolicy struct {
+	ApiVersion        string            `yaml:"apiVersion"`
+	Kind              string            `yaml:"kind"`
+	Metadata          map[string]any    `yaml:"metadata,omitempty"`
+	Rules             []auditPolicyRule `yaml:"rules"`
+	OmitStages        []string          `yaml:"omitStages,omitempty"`
+	OmitManagedFields *bool             `yaml:"omitManagedFields,omitempty"`
+}
+
+type auditPolicyRule struct {
+	Level             string                `yaml:"level"`
+	Users             []string              `yaml:"users,omitempty"`
+	UserGroups        []string              `yaml:"userGroups,omitempty"`
+	Verbs             []string              `yaml:"verbs,omitempty"`
+	Resources         []auditGroupResources `yaml:"resources,omitempty"`
+	Namespaces        []string              `yaml:"namespaces,omitempty"`
+	NonResourceURLs   []string              `yaml:"nonResourceURLs,omitempty"`
+	OmitStages        []string              `yaml:"omitStages,omitempty"`
+	OmitManagedFields *bool                 `yaml:"omitManagedFields,omitempty"`
+}
+
+type auditGroupResources struct {
+	Group         string   `yaml:"group"`
+	Resources     []string `yaml:"resources,omitempty"`
+	ResourceNames []string `yaml:"resourceNames,omitempty"`
+}
+
+// parseAuditPolicy decodes and validates a raw audit policy document
+func parseAuditPolicy(document string) (*auditPolicy, error) {
+	var policy auditPolicy
+
+	decoder := yaml.NewDecoder(bytes.NewBufferString(document))
+	decoder.KnownFields(true)
+	err := decoder.Decode(&policy)
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("audit policy is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not parse audit policy: %w", err)
+	}
+
+	if policy.ApiVersion != auditPolicyApiVersion {
+		return nil, fmt.Errorf("audit policy apiVersion should be %q, got %q", auditPolicyApiVersion, policy.ApiVersion)
+	}
+	if policy.Kind != auditPolicyKind {
+		return nil, fmt.Errorf("audit policy kind should be %q, got %q", auditPolicyKind, policy.Kind)
+	}
+	if len(policy.Rules) == 0 {
+		return nil, fmt.Errorf("audit policy should have at least one rule")
+	}
+
+	var errs error
+	errs = errors.Join(errs, validateAuditStages("omitStages", policy.OmitStages))
+	for i, rule := range policy.Rules {
+		if !slices.Contains(auditLevels, rule.Level) {
+			errs = errors.Join(errs, fmt.Errorf("rules[%d].level should be one of %v, got %q", i, auditLevels, rule.Level))
+		}
+		errs = errors.Join(errs, validateAuditStages(fmt.Sprintf("rules[%d].omitStages", i), rule.OmitStages))
+	}
+	if errs != nil {
+		return nil, errs
+	}
+
+	return &policy, nil
+}
+
+func validateAuditStages(field string, stages []string) error {
+	for _, stage := range stages {
+		if !slices.Contains(auditStages, stage) {
+			return fmt.Errorf("%s should only contain %v, got %q", field, auditStages, stage)
+		}
+	}
+	return nil
+}
+
+// renderAuditPolicy builds the audit policy document sent to the API from the structured rules
+func renderAuditPolicy(ctx context.Context, rules []AuditPolicyRuleModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := auditPolicy{
+		ApiVersion: auditPolicyApiVersion,
+		Kind:       auditPolicyKind,
+		Rules:      make([]auditPolicyRule, len(rules)),
+	}
+
+	for i, rule := range rules {
+		policy.Rules[i] = auditPolicyRule{
+			Level:      rule.Level.ValueString(),
+			Users:      listToStrings(ctx, rule.Users, &diags),
+			UserGroups: listToStrings(ctx, rule.Groups, &diags),
+			Verbs:      listToStrings(ctx, rule.Verbs, &diags),
+			Namespaces: listToStrings(ctx, rule.Namespaces, &diags),
+			OmitStages: listToStrings(ctx, rule.OmitStages, &diags),
+		}
+
+		for _, resource := range rule.Resources {
+			policy.Rules[i].Resources = append(policy.Rules[i].Resources, auditGroupResources{
+				Group:         resource.Group.ValueString(),
+				Resources:     listToStrings(ctx, resource.Resources, &diags),
+				ResourceNames: listToStrings(ctx, resource.ResourceNames, &diags),
+			})
+		}
+	}
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	document, err := yaml.Marshal(policy)
+	if err != nil {
+		diags.AddError("could not render audit policy", err.Error())
+		return "", diags
+	}
+
+	return string(document), diags
+}
+
+// auditPolicyRulesFrom converts a policy document back to structured rules.
+// It fails when the document uses settings the structured form cannot express.
+func auditPolicyRulesFrom(ctx context.Context, document string) ([]AuditPolicyRuleModel, error) {
+	policy, err := parseAuditPolicy(document)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.OmitStages) > 0 || policy.OmitManagedFields != nil {
+		return nil, fmt.Errorf("audit policy uses policy wide settings")
+	}
+
+	rules := make([]AuditPolicyRuleModel, len(policy.Rules))
+	for i, rule := range policy.Rules {
+		if len(rule.NonResourceURLs) > 0 || rule.OmitManagedFields != nil {
+			return nil, fmt.Errorf("audit policy rule %d uses settings unavailable in policy_rules", i)
+		}
+
+		rules[i] = AuditPolicyRuleModel{
+			Level:      types.StringValue(rule.Level),
+			Users:      stringsToList(ctx, rule.Users),
+			Groups:     stringsToList(ctx, rule.UserGroups),
+			Verbs:      stringsToList(ctx, rule.Verbs),
+			Namespaces: stringsToList(ctx, rule.Namespaces),
+			OmitStages: stringsToList(ctx, rule.OmitStages),
+		}
+
+		for _, resource := range rule.Resources {
+			rules[i].Resources = append(rules[i].Resources, AuditPolicyResourceModel{
+				Group:         types.StringValue(resource.Group),
+				Resources:     stringsToList(ctx, resource.Resources),
+				ResourceNames: stringsToList(ctx, resource.ResourceNames),
+			})
+		}
+	}
+
+	return rules, nil
+}
+
+func listToStrings(ctx context.Context, list types.List, diags *diag.Diagnostics) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	values := make([]string, 0, len(list.Elements()))
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// stringsToList returns a null list for empty slices, as the rendered document omits them
+func stringsToList(ctx context.Context, values []string) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+
+	list, _ := types.ListValue(types.StringType, elements)
+	return list
+}
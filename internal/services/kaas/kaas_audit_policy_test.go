@@ -0,0 +1,69 @@
+package kaas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("parseAuditPolicy",
+	func(document string, matcher OmegaMatcher) {
+		_, err := parseAuditPolicy(document)
+		Expect(err).To(matcher)
+	},
+	Entry("good", "apiVersion: audit.k8s.io/v1\nkind: Policy\nomitStages: [RequestReceived]\nrules:\n  - level: Metadata\n    nonResourceURLs: [/healthz]\n", Succeed()),
+	Entry("empty", "", MatchError(ContainSubstring("audit policy is empty"))),
+	Entry("unknown field", "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n  - level: Metadata\n    verb: [get]\n", MatchError(ContainSubstring("field verb not found"))),
+	Entry("wrong api version", "apiVersion: audit.k8s.io/v1beta1\nkind: Policy\nrules:\n  - level: Metadata\n", MatchError(ContainSubstring("apiVersion should be"))),
+	Entry("wrong kind", "apiVersion: audit.k8s.io/v1\nkind: Config\nrules:\n  - level: Metadata\n", MatchError(ContainSubstring("kind should be"))),
+	Entry("no rules", "apiVersion: audit.k8s.io/v1\nkind: Policy\n", MatchError(ContainSubstring("at least one rule"))),
+	Entry("bad level", "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n  - level: Metadta\n", MatchError(ContainSubstring("rules[0].level should be one of"))),
+	Entry("bad stage", "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n  - level: None\n    omitStages: [Received]\n", MatchError(ContainSubstring("rules[0].omitStages should only contain"))),
+)
+
+var _ = Describe("auditPolicyRulesFrom", func() {
+	ctx := context.Background()
+
+	It("reads back the rendered rules", func() {
+		rules := []AuditPolicyRuleModel{
+			{
+				Level:      types.StringValue("None"),
+				Users:      stringsToList(ctx, []string{"system:kube-proxy"}),
+				Groups:     types.ListNull(types.StringType),
+				Verbs:      stringsToList(ctx, []string{"watch"}),
+				Namespaces: types.ListNull(types.StringType),
+				OmitStages: types.ListNull(types.StringType),
+				Resources: []AuditPolicyResourceModel{
+					{
+						Group:         types.StringValue(""),
+						Resources:     stringsToList(ctx, []string{"endpoints", "services"}),
+						ResourceNames: types.ListNull(types.StringType),
+					},
+				},
+			},
+			{
+				Level:      types.StringValue("Metadata"),
+				Users:      types.ListNull(types.StringType),
+				Groups:     stringsToList(ctx, []string{"system:authenticated"}),
+				Verbs:      types.ListNull(types.StringType),
+				Namespaces: stringsToList(ctx, []string{"kube-system"}),
+				OmitStages: stringsToList(ctx, []string{"RequestReceived"}),
+			},
+		}
+
+		document, diags := renderAuditPolicy(ctx, rules)
+		Expect(diags.HasError()).To(BeFalse(), "%v", diags)
+
+		parsed, err := auditPolicyRulesFrom(ctx, document)
+		Expect(err).NotTo(HaveOccurred(), document)
+		Expect(parsed).To(Equal(rules))
+	})
+
+	It("rejects what the structured form cannot hold", func() {
+		document := "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n  - level: Metadata\n    nonResourceURLs: [/healthz]\n"
+		_, err := auditPolicyRulesFrom(ctx, document)
+		Expect(err).To(HaveOccurred())
+	})
+})
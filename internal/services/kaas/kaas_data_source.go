@@ -2,6 +2,7 @@ package kaas
 
 import (
 	"context"
+	"fmt"
 	"terraform-provider-infomaniak/internal/apis"
 	"terraform-provider-infomaniak/internal/provider"
 
@@ -54,6 +55,21 @@ func (d *kaasDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	var data KaasModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() {
+		id, err := d.lookupKaasByName(data)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to find KaaS",
+				err.Error(),
+			)
+			return
+		}
+		data.Id = types.Int64Value(id)
+	}
 
 	obj, err := d.client.Kaas.GetKaas(
 		data.PublicCloudId.ValueInt64(),
@@ -82,8 +98,16 @@ func (d *kaasDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	data.Kubeconfig = types.StringValue(kubeconfig)
+	data.Name = types.StringValue(obj.Name)
 	data.Region = types.StringValue(obj.Region)
 	data.KubernetesVersion = types.StringValue(obj.KubernetesVersion)
+	data.fillStatus(obj)
+	data.fillMaintenance(obj)
+	if obj.Network != nil {
+		data.Network = &KaasNetworkModel{}
+	}
+	data.fillNetwork(obj.Network)
+	data.fillTags(obj.Tags, nil)
 
 	apiserverParams, err := d.client.Kaas.GetApiserverParams(
 		data.PublicCloudId.ValueInt64(),
@@ -102,6 +126,14 @@ func (d *kaasDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		data.fillApiserverState(ctx, apiserverParams)
 	}
 
+	if data.Apiserver != nil && data.Apiserver.Audit != nil && !data.Apiserver.Audit.Policy.IsNull() {
+		// Expose the structured form too whenever the remote policy fits in it
+		rules, err := auditPolicyRulesFrom(ctx, data.Apiserver.Audit.Policy.ValueString())
+		if err == nil {
+			data.Apiserver.Audit.PolicyRules = rules
+		}
+	}
+
 	ipFilters, err := d.client.Kaas.GetIPFilters(data.PublicCloudId.ValueInt64(), data.PublicCloudProjectId.ValueInt64(), data.Id.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -123,6 +155,33 @@ func (d *kaasDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 }
 
+// lookupKaasByName returns the id of the only KaaS of the project with the configured name
+func (d *kaasDataSource) lookupKaasByName(data KaasModel) (int64, error) {
+	kaases, err := d.client.Kaas.GetKaases(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []int64
+	for _, kaasObject := range kaases {
+		if kaasObject.Name == data.Name.ValueString() {
+			ids = append(ids, kaasObject.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("no KaaS named %q in public cloud project %d", data.Name.ValueString(), data.PublicCloudProjectId.ValueInt64())
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("%d KaaS are named %q in public cloud project %d (ids %v), use id instead", len(ids), data.Name.ValueString(), data.PublicCloudProjectId.ValueInt64(), ids)
+	}
+}
+
 // Metadata returns the data source type name.
 func (d *kaasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kaas"
@@ -1,7 +1,10 @@
 package kaas
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,14 +22,19 @@ func getKaasDataSourceSchema() schema.Schema {
 				MarkdownDescription: "The id of the public cloud project where KaaS is installed",
 			},
 			"id": schema.Int64Attribute{
-				Required:            true,
-				Description:         "The id of this KaaS",
-				MarkdownDescription: "The id of this KaaS",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The id of this KaaS, either id or name must be set",
+				MarkdownDescription: "The id of this KaaS, either `id` or `name` must be set",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "The name of the KaaS project",
-				MarkdownDescription: "The name of the KaaS project",
+				Description:         "The name of the KaaS project, it must match exactly one KaaS of the public cloud project",
+				MarkdownDescription: "The name of the KaaS project, it must match exactly one KaaS of the public cloud project",
 			},
 			"pack_name": schema.StringAttribute{
 				Computed:            true,
@@ -49,6 +57,84 @@ func getKaasDataSourceSchema() schema.Schema {
 				Description:         "The version of Kubernetes associated with the KaaS project",
 				MarkdownDescription: "The version of Kubernetes associated with the KaaS project",
 			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the KaaS, `Active` once the cluster is ready",
+			},
+			"apiserver_endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the Kubernetes Apiserver",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The creation date of the KaaS, in RFC 3339 format",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date of the last update of the KaaS, in RFC 3339 format",
+			},
+			"network": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The networking options of the cluster",
+				Attributes: map[string]schema.Attribute{
+					"pod_cidr": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The CIDR block the pod IPs are allocated from",
+					},
+					"service_cidr": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The CIDR block the service IPs are allocated from",
+					},
+					"network_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The id of the private network the cluster is attached to",
+					},
+					"subnet_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The id of the subnet the nodes are attached to",
+					},
+				},
+			},
+			"current_kubernetes_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of Kubernetes running on the KaaS",
+			},
+			"auto_upgrade": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The versions the platform may upgrade the control plane to on its own, one of `none`, `patch` or `minor`",
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The weekly slot during which the control plane may be upgraded",
+				Attributes: map[string]schema.Attribute{
+					"day": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The day of the week the maintenance window starts",
+					},
+					"start_time": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The time the maintenance window starts, in `HH:MM` format",
+					},
+					"duration": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The length of the maintenance window in hours",
+					},
+					"timezone": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The IANA timezone of `start_time`",
+					},
+				},
+			},
+			"tags": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the KaaS",
+			},
+			"tags_all": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "All the tags of the KaaS, same as tags for the data source",
+			},
 			"apiserver": schema.SingleNestedAttribute{
 				Description:         "Kubernetes Apiserver editable params",
 				MarkdownDescription: "Kubernetes Apiserver editable params",
@@ -76,6 +162,65 @@ func getKaasDataSourceSchema() schema.Schema {
 								MarkdownDescription: "YAML manifest for audit policy",
 								Computed:            true,
 							},
+							"policy_rules": schema.ListNestedAttribute{
+								MarkdownDescription: "Structured audit policy rules, only set when the audit policy can be expressed with them",
+								Computed:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"level": schema.StringAttribute{
+											Computed:            true,
+											MarkdownDescription: "The audit level of the events matching this rule",
+										},
+										"users": schema.ListAttribute{
+											Computed:            true,
+											ElementType:         types.StringType,
+											MarkdownDescription: "Users this rule applies to",
+										},
+										"groups": schema.ListAttribute{
+											Computed:            true,
+											ElementType:         types.StringType,
+											MarkdownDescription: "User groups this rule applies to",
+										},
+										"verbs": schema.ListAttribute{
+											Computed:            true,
+											ElementType:         types.StringType,
+											MarkdownDescription: "Verbs this rule applies to",
+										},
+										"namespaces": schema.ListAttribute{
+											Computed:            true,
+											ElementType:         types.StringType,
+											MarkdownDescription: "Namespaces this rule applies to",
+										},
+										"omit_stages": schema.ListAttribute{
+											Computed:            true,
+											ElementType:         types.StringType,
+											MarkdownDescription: "Stages for which no event is generated",
+										},
+										"resources": schema.ListNestedAttribute{
+											Computed:            true,
+											MarkdownDescription: "Resources this rule applies to",
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"group": schema.StringAttribute{
+														Computed:            true,
+														MarkdownDescription: "API group of the resources",
+													},
+													"resources": schema.ListAttribute{
+														Computed:            true,
+														ElementType:         types.StringType,
+														MarkdownDescription: "Resources of the group this rule applies to",
+													},
+													"resource_names": schema.ListAttribute{
+														Computed:            true,
+														ElementType:         types.StringType,
+														MarkdownDescription: "Names of the resources this rule applies to",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 					},
 					"oidc": schema.SingleNestedAttribute{
@@ -19,6 +19,32 @@ func TestKaasDatasource_Schema(t *testing.T) {
 					Check: resource.ComposeTestCheckFunc(
 						resource.TestCheckResourceAttr("data.infomaniak_kaas.kluster", "region", "dc5"),
 						resource.TestCheckResourceAttrSet("data.infomaniak_kaas.kluster", "kubeconfig"),
+						resource.TestCheckResourceAttr("data.infomaniak_kaas.kluster", "status", "Active"),
+					),
+				},
+			},
+		},
+		"data_source.kaas.by_name": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "data_source_kaas_by_name.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair("data.infomaniak_kaas.kluster", "id", "infomaniak_kaas.kluster", "id"),
+						resource.TestCheckResourceAttr("data.infomaniak_kaas.kluster", "region", "dc5"),
+					),
+				},
+			},
+		},
+		"data_source.kaas_list.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "data_source_kaas_list_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.infomaniak_kaas_list.all", "kaas.#", "2"),
+						resource.TestCheckResourceAttr("data.infomaniak_kaas_list.filtered", "kaas.#", "1"),
+						resource.TestCheckResourceAttrPair("data.infomaniak_kaas_list.filtered", "kaas.0.id", "infomaniak_kaas.other", "id"),
 					),
 				},
 			},
@@ -28,7 +54,7 @@ func TestKaasDatasource_Schema(t *testing.T) {
 			Steps: []resource.TestStep{
 				{
 					Config:      test.MustGetTestFile("schema", "data_source_kaas_missing_id.tf"),
-					ExpectError: regexp.MustCompile(`The argument "id" is required, but no definition was found.`),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
 				},
 			},
 		},
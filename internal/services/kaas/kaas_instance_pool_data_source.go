@@ -2,6 +2,7 @@ package kaas
 
 import (
 	"context"
+	"fmt"
 	"terraform-provider-infomaniak/internal/apis"
 	"terraform-provider-infomaniak/internal/provider"
 
@@ -54,6 +55,21 @@ func (d *kaasInstancePoolDataSource) Read(ctx context.Context, req datasource.Re
 	var data KaasInstancePoolModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() {
+		id, err := d.lookupInstancePoolByName(data)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to find KaaS instance pool",
+				err.Error(),
+			)
+			return
+		}
+		data.Id = types.Int64Value(id)
+	}
 
 	obj, err := d.client.Kaas.GetInstancePool(
 		data.PublicCloudId.ValueInt64(),
@@ -86,6 +102,34 @@ func (d *kaasInstancePoolDataSource) Read(ctx context.Context, req datasource.Re
 	}
 }
 
+// lookupInstancePoolByName returns the id of the only instance pool of the KaaS with the configured name
+func (d *kaasInstancePoolDataSource) lookupInstancePoolByName(data KaasInstancePoolModel) (int64, error) {
+	instancePools, err := d.client.Kaas.GetInstancePools(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.KaasId.ValueInt64(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []int64
+	for _, instancePool := range instancePools {
+		if instancePool.Name == data.Name.ValueString() {
+			ids = append(ids, instancePool.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("no instance pool named %q in KaaS %d", data.Name.ValueString(), data.KaasId.ValueInt64())
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("%d instance pools are named %q in KaaS %d (ids %v), use id instead", len(ids), data.Name.ValueString(), data.KaasId.ValueInt64(), ids)
+	}
+}
+
 // Metadata returns the data source type name.
 func (d *kaasInstancePoolDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kaas_instance_pool"
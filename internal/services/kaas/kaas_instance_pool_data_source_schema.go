@@ -1,7 +1,10 @@
 package kaas
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,12 +24,17 @@ func getKaasInstancePoolDataSourceSchema() schema.Schema {
 				Description: "The id of the kaas project.",
 			},
 			"id": schema.Int64Attribute{
-				Required:    true,
-				Description: "The unique identifier for the instance pool.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier for the instance pool, either id or name must be set.",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The name of this instance pool",
+				Description: "The name of this instance pool, it must match exactly one instance pool of the KaaS project",
 			},
 			"availability_zone": schema.StringAttribute{
 				Computed:            true,
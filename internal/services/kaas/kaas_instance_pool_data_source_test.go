@@ -26,12 +26,37 @@ func TestKaasInstancePoolDatasource_Schema(t *testing.T) {
 				},
 			},
 		},
+		"data_source.kaas_instance_pool.by_name": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "data_source_kaas_instance_pool_by_name.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair("data.infomaniak_kaas_instance_pool.instance_pool", "id", "infomaniak_kaas_instance_pool.instance_pool", "id"),
+						resource.TestCheckResourceAttr("data.infomaniak_kaas_instance_pool.instance_pool", "flavor_name", "test"),
+					),
+				},
+			},
+		},
+		"data_source.kaas_instance_pools.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "data_source_kaas_instance_pools_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.infomaniak_kaas_instance_pools.all", "instance_pools.#", "1"),
+						resource.TestCheckResourceAttr("data.infomaniak_kaas_instance_pools.all", "instance_pools.0.name", "coucou"),
+						resource.TestCheckResourceAttr("data.infomaniak_kaas_instance_pools.by_label", "instance_pools.#", "0"),
+					),
+				},
+			},
+		},
 		"data_source.kaas_instance_pool.missing_id": {
 			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
 			Steps: []resource.TestStep{
 				{
 					Config:      test.MustGetTestFile("schema", "data_source_kaas_instance_pool_missing_id.tf"),
-					ExpectError: regexp.MustCompile(`The argument "id" is required, but no definition was found.`),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
 				},
 			},
 		},
@@ -80,12 +105,12 @@ func TestKaasInstancePoolDatasource_Schema(t *testing.T) {
 				},
 			},
 		},
-		"data_source.kaas_instance_pool.cant_specify_name": {
+		"data_source.kaas_instance_pool.id_and_name": {
 			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
 			Steps: []resource.TestStep{
 				{
-					Config:      test.MustGetTestFile("schema", "data_source_kaas_instance_pool_cant_specify_name.tf"),
-					ExpectError: regexp.MustCompile(`[0-9]+:( )*name( )*=`),
+					Config:      test.MustGetTestFile("schema", "data_source_kaas_instance_pool_id_and_name.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
 				},
 			},
 		},
@@ -9,6 +9,7 @@ import (
 	"terraform-provider-infomaniak/internal/apis"
 	"terraform-provider-infomaniak/internal/apis/kaas"
 	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/utils"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -44,6 +45,13 @@ type KaasInstancePoolModel struct {
 	Labels           types.Map    `tfsdk:"labels"`
 }
 
+// kaasInstancePoolResourceModel adds the attributes only known by the resource to the ones shared with the data source
+type kaasInstancePoolResourceModel struct {
+	KaasInstancePoolModel
+
+	Timeouts *utils.Timeouts `tfsdk:"timeouts"`
+}
+
 func (r *kaasInstancePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kaas_instance_pool"
 }
@@ -73,7 +81,7 @@ func (r *kaasInstancePoolResource) Schema(ctx context.Context, req resource.Sche
 }
 
 func (r *kaasInstancePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data KaasInstancePoolModel
+	var data kaasInstancePoolResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +97,7 @@ func (r *kaasInstancePoolResource) Create(ctx context.Context, req resource.Crea
 		FlavorName:       data.FlavorName.ValueString(),
 		MinInstances:     data.MinInstances.ValueInt64(),
 		MaxInstances:     data.MaxInstances.ValueInt64(),
-		Labels:           r.getLabelsValues(data),
+		Labels:           r.getLabelsValues(data.KaasInstancePoolModel),
 	}
 
 	// CreateKaas API call logic
@@ -110,7 +118,7 @@ func (r *kaasInstancePoolResource) Create(ctx context.Context, req resource.Crea
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	isScalingDown := false
-	instancePoolObject, err := r.waitUntilActive(ctx, data, instancePoolId, isScalingDown)
+	instancePoolObject, err := r.waitUntilActive(ctx, data.KaasInstancePoolModel, instancePoolId, isScalingDown)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error when waiting for KaaS Instance Pool to be Active",
@@ -184,7 +192,7 @@ func (r *kaasInstancePoolResource) waitUntilActive(ctx context.Context, data Kaa
 }
 
 func (r *kaasInstancePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data KaasInstancePoolModel
+	var data kaasInstancePoolResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -222,8 +230,8 @@ func (r *kaasInstancePoolResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *kaasInstancePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state KaasInstancePoolModel
-	var data KaasInstancePoolModel
+	var state kaasInstancePoolResourceModel
+	var data kaasInstancePoolResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -242,7 +250,7 @@ func (r *kaasInstancePoolResource) Update(ctx context.Context, req resource.Upda
 		FlavorName:   data.FlavorName.ValueString(),
 		MinInstances: data.MinInstances.ValueInt64(),
 		MaxInstances: data.MaxInstances.ValueInt64(),
-		Labels:       r.getLabelsValues(data),
+		Labels:       r.getLabelsValues(data.KaasInstancePoolModel),
 	}
 
 	_, err := r.client.Kaas.UpdateInstancePool(
@@ -259,7 +267,7 @@ func (r *kaasInstancePoolResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	scalingDown := data.MaxInstances.ValueInt64() < state.MaxInstances.ValueInt64()
-	instancePoolObject, err := r.waitUntilActive(ctx, data, state.Id.ValueInt64(), scalingDown)
+	instancePoolObject, err := r.waitUntilActive(ctx, data.KaasInstancePoolModel, state.Id.ValueInt64(), scalingDown)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error when waiting for KaaS Instance Pool to be Active",
@@ -279,7 +287,7 @@ func (r *kaasInstancePoolResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *kaasInstancePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data KaasInstancePoolModel
+	var data kaasInstancePoolResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -302,6 +310,26 @@ func (r *kaasInstancePoolResource) Delete(ctx context.Context, req resource.Dele
 		)
 		return
 	}
+
+	err = utils.WaitUntilDeleted(ctx, data.Timeouts.DeleteTimeout(), func() (bool, error) {
+		found, err := r.client.Kaas.GetInstancePool(
+			data.PublicCloudId.ValueInt64(),
+			data.PublicCloudProjectId.ValueInt64(),
+			data.KaasId.ValueInt64(),
+			data.Id.ValueInt64(),
+		)
+		if err != nil {
+			return false, err
+		}
+		return found.Status == "Deleted", nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when waiting for instance pool to be deleted",
+			err.Error(),
+		)
+		return
+	}
 }
 
 func (r *kaasInstancePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -1,6 +1,8 @@
 package kaas
 
 import (
+	"terraform-provider-infomaniak/internal/utils"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -94,6 +96,7 @@ func getKaasInstancePoolResourceSchema() schema.Schema {
 				Description:         "Kubernetes labels to apply to the instances. The label must have a prefix of node-role.kubernetes.io or belong to the domains node-restriction.kubernetes.io or custom.kaas.infomaniak.cloud.",
 				MarkdownDescription: "Kubernetes labels to apply to the instances. The label must have a prefix of node-role.kubernetes.io or belong to the domains node-restriction.kubernetes.io or custom.kaas.infomaniak.cloud.",
 			},
+			"timeouts": utils.TimeoutsAttribute(),
 		},
 		MarkdownDescription: "The kaas instance pool resource is used to manage instance pools inside a kaas project",
 	}
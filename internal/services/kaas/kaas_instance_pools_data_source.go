@@ -0,0 +1,158 @@
+package kaas
+
+import (
+	"context"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/kaas"
+	"terraform-provider-infomaniak/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &kaasInstancePoolsDataSource{}
+	_ datasource.DataSourceWithConfigure = &kaasInstancePoolsDataSource{}
+)
+
+type kaasInstancePoolsDataSource struct {
+	client *apis.Client
+}
+
+type KaasInstancePoolsModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+	KaasId               types.Int64 `tfsdk:"kaas_id"`
+
+	Name   types.String `tfsdk:"name"`
+	Labels types.Map    `tfsdk:"labels"`
+
+	InstancePools []KaasInstancePoolSummaryModel `tfsdk:"instance_pools"`
+}
+
+type KaasInstancePoolSummaryModel struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	FlavorName       types.String `tfsdk:"flavor_name"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	MinInstances     types.Int64  `tfsdk:"min_instances"`
+	MaxInstances     types.Int64  `tfsdk:"max_instances"`
+	Status           types.String `tfsdk:"status"`
+	Labels           types.Map    `tfsdk:"labels"`
+}
+
+// matches reports whether the instance pool has the filtered name and at least the filtered labels
+func (model *KaasInstancePoolsModel) matches(ctx context.Context, instancePool *kaas.InstancePool) (bool, diag.Diagnostics) {
+	if !matchesFilter(model.Name, instancePool.Name) {
+		return false, nil
+	}
+
+	labels := make(map[string]string)
+	if !model.Labels.IsNull() {
+		diags := model.Labels.ElementsAs(ctx, &labels, false)
+		if diags.HasError() {
+			return false, diags
+		}
+	}
+
+	for key, value := range labels {
+		if instancePoolValue, found := instancePool.Labels[key]; !found || instancePoolValue != value {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (model *KaasInstancePoolSummaryModel) fill(ctx context.Context, instancePool *kaas.InstancePool) diag.Diagnostics {
+	model.Id = types.Int64Value(instancePool.Id)
+	model.Name = types.StringValue(instancePool.Name)
+	model.FlavorName = types.StringValue(instancePool.FlavorName)
+	model.AvailabilityZone = types.StringValue(instancePool.AvailabilityZone)
+	model.MinInstances = types.Int64Value(instancePool.MinInstances)
+	model.MaxInstances = types.Int64Value(instancePool.MaxInstances)
+	model.Status = types.StringValue(instancePool.Status)
+
+	labels, diags := types.MapValueFrom(ctx, types.StringType, instancePool.Labels)
+	model.Labels = labels
+	return diags
+}
+
+// NewKaasInstancePoolsDataSource is a helper function to simplify the provider implementation.
+func NewKaasInstancePoolsDataSource() datasource.DataSource {
+	return &kaasInstancePoolsDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *kaasInstancePoolsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *kaasInstancePoolsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = getKaasInstancePoolsDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *kaasInstancePoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KaasInstancePoolsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instancePools, err := d.client.Kaas.GetInstancePools(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.KaasId.ValueInt64(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list KaaS instance pools",
+			err.Error(),
+		)
+		return
+	}
+
+	data.InstancePools = make([]KaasInstancePoolSummaryModel, 0, len(instancePools))
+	for _, instancePool := range instancePools {
+		matches, diags := data.matches(ctx, instancePool)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !matches {
+			continue
+		}
+
+		var summary KaasInstancePoolSummaryModel
+		resp.Diagnostics.Append(summary.fill(ctx, instancePool)...)
+		data.InstancePools = append(data.InstancePools, summary)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Metadata returns the data source type name.
+func (d *kaasInstancePoolsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kaas_instance_pools"
+}
@@ -0,0 +1,76 @@
+package kaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func getKaasInstancePoolsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"public_cloud_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The id of the public cloud where KaaS is installed",
+			},
+			"public_cloud_project_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The id of the public cloud project where KaaS is installed",
+			},
+			"kaas_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The id of the kaas project.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the instance pools with this name",
+			},
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only list the instance pools having all these Kubernetes node labels",
+			},
+			"instance_pools": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The instance pools of the KaaS project matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The unique identifier for the instance pool",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the instance pool",
+						},
+						"flavor_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The flavor name of the instances in the instance pool",
+						},
+						"availability_zone": schema.StringAttribute{
+							Computed:    true,
+							Description: "The availability zone for the instances in the pool",
+						},
+						"min_instances": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The minimum amount of instances in the instance pool",
+						},
+						"max_instances": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The maximum amount of instances in the instance pool",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the instance pool",
+						},
+						"labels": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Kubernetes node labels",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "The KaaS Instance Pools data source retrieves the instance pools of a KaaS project.",
+	}
+}
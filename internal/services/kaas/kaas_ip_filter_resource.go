@@ -0,0 +1,308 @@
+package kaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &kaasIpFilterResource{}
+	_ resource.ResourceWithConfigure      = &kaasIpFilterResource{}
+	_ resource.ResourceWithImportState    = &kaasIpFilterResource{}
+	_ resource.ResourceWithValidateConfig = &kaasIpFilterResource{}
+
+	// ipFiltersLocks serializes the read-modify-write cycles on the ip filters of a KaaS,
+	// several rules targeting the same cluster are applied concurrently by Terraform
+	ipFiltersLocks sync.Map
+)
+
+func lockIPFilters(publicCloudId, projectId, kaasId int64) func() {
+	key := fmt.Sprintf("%d-%d-%d", publicCloudId, projectId, kaasId)
+	lock, _ := ipFiltersLocks.LoadOrStore(key, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+func NewKaasIpFilterResource() resource.Resource {
+	return &kaasIpFilterResource{}
+}
+
+type kaasIpFilterResource struct {
+	client *apis.Client
+}
+
+type KaasIpFilterModel struct {
+	PublicCloudId        types.Int64  `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64  `tfsdk:"public_cloud_project_id"`
+	KaasId               types.Int64  `tfsdk:"kaas_id"`
+	Id                   types.String `tfsdk:"id"`
+
+	Cidr types.String `tfsdk:"cidr"`
+}
+
+func (r *kaasIpFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kaas_ip_filter"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *kaasIpFilterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *kaasIpFilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = getKaasIpFilterResourceSchema()
+}
+
+func (r *kaasIpFilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data KaasIpFilterModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Cidr.IsNull() || data.Cidr.IsUnknown() {
+		return
+	}
+
+	// The API stores the masked prefix, anything else would show up as a perpetual diff
+	if _, err := parseNetworkCidr(data.Cidr.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cidr"), "invalid cidr format", err.Error())
+	}
+}
+
+func (r *kaasIpFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KaasIpFilterModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(data.Cidr.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid cidr format", err.Error())
+		return
+	}
+
+	publicCloudId := data.PublicCloudId.ValueInt64()
+	projectId := data.PublicCloudProjectId.ValueInt64()
+	kaasId := data.KaasId.ValueInt64()
+
+	unlock := lockIPFilters(publicCloudId, projectId, kaasId)
+	defer unlock()
+
+	ipFilters, err := r.client.Kaas.GetIPFilters(publicCloudId, projectId, kaasId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get IP filter",
+			err.Error(),
+		)
+		return
+	}
+
+	// Taking over an existing rule would remove it for its other owners on destroy
+	if slices.Contains(ipFilters, prefix) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cidr"),
+			"IP filter already exists",
+			fmt.Sprintf(
+				"%s is already allowed on KaaS %d, import it with: terraform import <address> %d,%d,%d,%s",
+				prefix, kaasId, publicCloudId, projectId, kaasId, prefix,
+			),
+		)
+		return
+	}
+
+	ok, err := r.client.Kaas.PutIPFilters(append(ipFilters, prefix), publicCloudId, projectId, kaasId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when applying ip filters", err.Error())
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError("Error when applying ip filters", "PutIPFilters returned false but no error was provided")
+		return
+	}
+
+	data.fill(prefix)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *kaasIpFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KaasIpFilterModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(data.Cidr.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid cidr format", err.Error())
+		return
+	}
+
+	ipFilters, err := r.client.Kaas.GetIPFilters(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.KaasId.ValueInt64(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get IP filter",
+			err.Error(),
+		)
+		return
+	}
+
+	// The rule was removed outside of this resource, it has to be created again
+	if !slices.Contains(ipFilters, prefix) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.fill(prefix)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *kaasIpFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires a replacement, there is nothing to update in place
+	var data KaasIpFilterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *kaasIpFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KaasIpFilterModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(data.Cidr.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid cidr format", err.Error())
+		return
+	}
+
+	publicCloudId := data.PublicCloudId.ValueInt64()
+	projectId := data.PublicCloudProjectId.ValueInt64()
+	kaasId := data.KaasId.ValueInt64()
+
+	unlock := lockIPFilters(publicCloudId, projectId, kaasId)
+	defer unlock()
+
+	ipFilters, err := r.client.Kaas.GetIPFilters(publicCloudId, projectId, kaasId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get IP filter",
+			err.Error(),
+		)
+		return
+	}
+
+	if !slices.Contains(ipFilters, prefix) {
+		return
+	}
+
+	remaining := slices.DeleteFunc(ipFilters, func(cidr netip.Prefix) bool {
+		return cidr == prefix
+	})
+
+	ok, err := r.client.Kaas.PutIPFilters(remaining, publicCloudId, projectId, kaasId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when removing ip filter", err.Error())
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError("Error when removing ip filter", "PutIPFilters returned false but no error was provided")
+	}
+}
+
+func (r *kaasIpFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: public_cloud_id,public_cloud_project_id,kaas_id,cidr. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var errorList error
+
+	publicCloudId, err := strconv.ParseInt(idParts[0], 10, 64)
+	errorList = errors.Join(errorList, err)
+	publicCloudProjectId, err := strconv.ParseInt(idParts[1], 10, 64)
+	errorList = errors.Join(errorList, err)
+	kaasId, err := strconv.ParseInt(idParts[2], 10, 64)
+	errorList = errors.Join(errorList, err)
+
+	if errorList != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: public_cloud_id,public_cloud_project_id,kaas_id,cidr. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Read only finds the masked prefix the API stores
+	prefix, err := parseNetworkCidr(idParts[3])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Invalid cidr in import identifier %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_id"), publicCloudId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_cloud_project_id"), publicCloudProjectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kaas_id"), kaasId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cidr"), prefix.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), prefix.String())...)
+}
+
+func (model *KaasIpFilterModel) fill(prefix netip.Prefix) {
+	model.Id = types.StringValue(prefix.String())
+	model.Cidr = types.StringValue(prefix.String())
+}
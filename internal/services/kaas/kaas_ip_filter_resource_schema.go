@@ -0,0 +1,56 @@
+package kaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func getKaasIpFilterResourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"public_cloud_id": schema.Int64Attribute{
+				Required:            true,
+				Description:         "The id of the public cloud where KaaS is installed",
+				MarkdownDescription: "The id of the public cloud where KaaS is installed",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"public_cloud_project_id": schema.Int64Attribute{
+				Required:            true,
+				Description:         "The id of the public cloud project where KaaS is installed",
+				MarkdownDescription: "The id of the public cloud project where KaaS is installed",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"kaas_id": schema.Int64Attribute{
+				Required:            true,
+				Description:         "The id of the kaas project.",
+				MarkdownDescription: "The id of the kaas project.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A computed value representing the unique identifier of the rule inside the KaaS project.",
+				MarkdownDescription: "A computed value representing the unique identifier of the rule inside the KaaS project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				Required:            true,
+				Description:         "The CIDR block allowed to access to control plane.",
+				MarkdownDescription: "The CIDR block allowed to access to control plane.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		MarkdownDescription: "The kaas ip filter resource adds a single CIDR block to the control plane ip filters of a kaas project",
+	}
+}
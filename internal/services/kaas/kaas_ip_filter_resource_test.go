@@ -0,0 +1,89 @@
+package kaas
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestKaasIpFilterResource_Schema(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"resource.kaas_ip_filter.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_kaas_ip_filter_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_kaas_ip_filter.office", "id", "192.168.0.0/24"),
+						resource.TestCheckResourceAttr("infomaniak_kaas_ip_filter.vpn", "id", "2001:db8::/32"),
+					),
+				},
+				{
+					ResourceName:      "infomaniak_kaas_ip_filter.office",
+					ImportState:       true,
+					ImportStateIdFunc: ipFilterImportStateId("infomaniak_kaas_ip_filter.office", "192.168.0.0/24"),
+					ImportStateVerify: true,
+				},
+				{
+					// Read only finds masked prefixes, an unmasked one would be silently dropped
+					ResourceName:      "infomaniak_kaas_ip_filter.office",
+					ImportState:       true,
+					ImportStateIdFunc: ipFilterImportStateId("infomaniak_kaas_ip_filter.office", "192.168.0.1/24"),
+					ExpectError:       regexp.MustCompile(`has host bits set, use 192.168.0.0/24 instead`),
+				},
+			},
+		},
+		"resource.kaas_ip_filter.duplicate": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_ip_filter_duplicate.tf"),
+					ExpectError: regexp.MustCompile(`192.168.0.0/24 is already allowed on KaaS`),
+				},
+			},
+		},
+		"resource.kaas_ip_filter.host_bits": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_ip_filter_host_bits.tf"),
+					ExpectError: regexp.MustCompile(`has host bits set, use 192.168.0.0/24 instead`),
+				},
+			},
+		},
+		"resource.kaas_ip_filter.missing_cidr": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_ip_filter_missing_cidr.tf"),
+					ExpectError: regexp.MustCompile(`The argument "cidr" is required, but no definition was found.`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
+
+// ipFilterImportStateId builds the public_cloud_id,public_cloud_project_id,kaas_id,cidr import identifier of an ip filter
+func ipFilterImportStateId(name string, cidr string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+
+		attributes := rs.Primary.Attributes
+		return fmt.Sprintf("%s,%s,%s,%s", attributes["public_cloud_id"], attributes["public_cloud_project_id"], attributes["kaas_id"], cidr), nil
+	}
+}
@@ -0,0 +1,203 @@
+package kaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/helpers"
+	"terraform-provider-infomaniak/internal/provider"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &kaasKubeconfigRotationResource{}
+	_ resource.ResourceWithConfigure = &kaasKubeconfigRotationResource{}
+)
+
+// rotationTimeout bounds how long Create waits for the new credentials to be issued
+const rotationTimeout = 15 * time.Minute
+
+func NewKaasKubeconfigRotationResource() resource.Resource {
+	return &kaasKubeconfigRotationResource{}
+}
+
+type kaasKubeconfigRotationResource struct {
+	client *apis.Client
+}
+
+type KaasKubeconfigRotationModel struct {
+	PublicCloudId        types.Int64  `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64  `tfsdk:"public_cloud_project_id"`
+	KaasId               types.Int64  `tfsdk:"kaas_id"`
+	Id                   types.String `tfsdk:"id"`
+
+	Triggers   types.Map    `tfsdk:"triggers"`
+	RotatedAt  types.String `tfsdk:"rotated_at"`
+	Kubeconfig types.String `tfsdk:"kubeconfig"`
+}
+
+func (r *kaasKubeconfigRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kaas_kubeconfig_rotation"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *kaasKubeconfigRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *kaasKubeconfigRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = getKaasKubeconfigRotationResourceSchema()
+}
+
+func (r *kaasKubeconfigRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KaasKubeconfigRotationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	publicCloudId := data.PublicCloudId.ValueInt64()
+	projectId := data.PublicCloudProjectId.ValueInt64()
+	kaasId := data.KaasId.ValueInt64()
+
+	previous, err := r.client.Kaas.GetKubeconfig(publicCloudId, projectId, kaasId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get kubeconfig from KaaS",
+			err.Error(),
+		)
+		return
+	}
+
+	rotated, err := r.client.Kaas.RotateKubeconfig(publicCloudId, projectId, kaasId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when rotating kubeconfig", err.Error())
+		return
+	}
+	if !rotated {
+		resp.Diagnostics.AddError("Error when rotating kubeconfig", "RotateKubeconfig returned false but no error was provided")
+		return
+	}
+
+	rotatedAt := time.Now().UTC()
+
+	ctx, cancel := context.WithTimeout(ctx, rotationTimeout)
+	defer cancel()
+
+	kubeconfig, err := r.waitForNewKubeconfig(ctx, data, previous)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when waiting for the new kubeconfig",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%d-%d", kaasId, rotatedAt.Unix()))
+	data.RotatedAt = types.StringValue(rotatedAt.Format(time.RFC3339))
+	data.Kubeconfig = types.StringValue(kubeconfig)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// waitForNewKubeconfig waits for the KaaS to be Active again and to hand out credentials other than previous
+func (r *kaasKubeconfigRotationResource) waitForNewKubeconfig(ctx context.Context, data KaasKubeconfigRotationModel, previous string) (string, error) {
+	publicCloudId := data.PublicCloudId.ValueInt64()
+	projectId := data.PublicCloudProjectId.ValueInt64()
+	kaasId := data.KaasId.ValueInt64()
+
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-t.C:
+			found, err := r.client.Kaas.GetKaas(publicCloudId, projectId, kaasId)
+			if err != nil {
+				return "", err
+			}
+			if found.Status != "Active" {
+				continue
+			}
+
+			kubeconfig, err := r.client.Kaas.GetKubeconfig(publicCloudId, projectId, kaasId)
+			if err != nil {
+				return "", err
+			}
+			if kubeconfig != previous {
+				return kubeconfig, nil
+			}
+		}
+	}
+}
+
+func (r *kaasKubeconfigRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KaasKubeconfigRotationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Kaas.GetKaas(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.KaasId.ValueInt64(),
+	)
+	// The cluster is gone, so are the credentials of this rotation
+	if errors.Is(err, helpers.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when reading KaaS",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *kaasKubeconfigRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires a replacement, there is nothing to update in place
+	var data KaasKubeconfigRotationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *kaasKubeconfigRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A rotation can't be undone, removing the resource only forgets about it
+}
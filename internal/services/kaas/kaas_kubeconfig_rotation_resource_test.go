@@ -0,0 +1,45 @@
+package kaas
+
+import (
+	"regexp"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestKaasKubeconfigRotationResource_Schema(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"resource.kaas_kubeconfig_rotation.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_kaas_kubeconfig_rotation_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("infomaniak_kaas_kubeconfig_rotation.rotation", "id"),
+						resource.TestCheckResourceAttrSet("infomaniak_kaas_kubeconfig_rotation.rotation", "rotated_at"),
+						resource.TestCheckResourceAttrSet("infomaniak_kaas_kubeconfig_rotation.rotation", "kubeconfig"),
+						resource.TestCheckResourceAttr("infomaniak_kaas_kubeconfig_rotation.rotation", "triggers.offboarded", "jdoe"),
+					),
+				},
+			},
+		},
+		"resource.kaas_kubeconfig_rotation.missing_kaas_id": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_kubeconfig_rotation_missing_kaas_id.tf"),
+					ExpectError: regexp.MustCompile(`The argument "kaas_id" is required, but no definition was found.`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.IsUnitTest = true
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package kaas
+
+import (
+	"context"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/kaas"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &kaasListDataSource{}
+	_ datasource.DataSourceWithConfigure = &kaasListDataSource{}
+)
+
+type kaasListDataSource struct {
+	client *apis.Client
+}
+
+type KaasListModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+
+	Name              types.String `tfsdk:"name"`
+	Region            types.String `tfsdk:"region"`
+	Status            types.String `tfsdk:"status"`
+	KubernetesVersion types.String `tfsdk:"kubernetes_version"`
+
+	Kaas []KaasSummaryModel `tfsdk:"kaas"`
+}
+
+type KaasSummaryModel struct {
+	Id                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	PackName          types.String `tfsdk:"pack_name"`
+	Region            types.String `tfsdk:"region"`
+	KubernetesVersion types.String `tfsdk:"kubernetes_version"`
+	Status            types.String `tfsdk:"status"`
+	ApiserverEndpoint types.String `tfsdk:"apiserver_endpoint"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+	Tags              types.Map    `tfsdk:"tags"`
+}
+
+func (model *KaasListModel) matches(kaas *kaas.Kaas) bool {
+	return matchesFilter(model.Name, kaas.Name) &&
+		matchesFilter(model.Region, kaas.Region) &&
+		matchesFilter(model.Status, kaas.Status) &&
+		matchesFilter(model.KubernetesVersion, kaas.KubernetesVersion)
+}
+
+func (model *KaasSummaryModel) fill(kaas *kaas.Kaas) {
+	model.Id = types.Int64Value(kaas.Id)
+	model.Name = types.StringValue(kaas.Name)
+	model.PackName = types.StringNull()
+	if kaas.Pack != nil {
+		model.PackName = types.StringValue(kaas.Pack.Name)
+	}
+	model.Region = types.StringValue(kaas.Region)
+	model.KubernetesVersion = types.StringValue(kaas.KubernetesVersion)
+	model.Status = types.StringValue(kaas.Status)
+	model.ApiserverEndpoint = types.StringValue(kaas.ApiserverEndpoint)
+	model.CreatedAt = utils.TimestampValue(kaas.CreatedAt)
+	model.UpdatedAt = utils.TimestampValue(kaas.UpdatedAt)
+	model.Tags, _ = utils.TagsFromApi(kaas.Tags, nil, types.MapNull(types.StringType))
+}
+
+// matchesFilter reports whether value satisfies an optional exact match filter
+func matchesFilter(filter types.String, value string) bool {
+	return filter.IsNull() || filter.ValueString() == value
+}
+
+// NewKaasListDataSource is a helper function to simplify the provider implementation.
+func NewKaasListDataSource() datasource.DataSource {
+	return &kaasListDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *kaasListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *kaasListDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = getKaasListDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *kaasListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KaasListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kaases, err := d.client.Kaas.GetKaases(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list KaaS",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Kaas = make([]KaasSummaryModel, 0, len(kaases))
+	for _, kaasObject := range kaases {
+		if !data.matches(kaasObject) {
+			continue
+		}
+
+		var summary KaasSummaryModel
+		summary.fill(kaasObject)
+		data.Kaas = append(data.Kaas, summary)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Metadata returns the data source type name.
+func (d *kaasListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kaas_list"
+}
@@ -0,0 +1,87 @@
+package kaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func getKaasListDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"public_cloud_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The id of the public cloud where KaaS are installed",
+			},
+			"public_cloud_project_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The id of the public cloud project where KaaS are installed",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the KaaS with this name",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the KaaS in this region",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the KaaS with this status",
+			},
+			"kubernetes_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the KaaS running this Kubernetes version",
+			},
+			"kaas": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The KaaS of the public cloud project matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The id of the KaaS",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the KaaS",
+						},
+						"pack_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the pack associated to the KaaS",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "The region where the KaaS resides",
+						},
+						"kubernetes_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Kubernetes version of the KaaS",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the KaaS",
+						},
+						"apiserver_endpoint": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL of the Kubernetes Apiserver",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The creation date of the KaaS, in RFC 3339 format",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date of the last update of the KaaS, in RFC 3339 format",
+						},
+						"tags": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags of the KaaS",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "The KaaS list data source retrieves the KaaS of a public cloud project.",
+	}
+}
@@ -0,0 +1,117 @@
+package kaas
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	autoUpgradeNone  = "none"
+	autoUpgradePatch = "patch"
+	autoUpgradeMinor = "minor"
+)
+
+var (
+	autoUpgradePolicies = []string{autoUpgradeNone, autoUpgradePatch, autoUpgradeMinor}
+	maintenanceDays     = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+	maintenanceStartTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+)
+
+var maintenanceWindowAttributeTypes = map[string]attr.Type{
+	"day":        types.StringType,
+	"start_time": types.StringType,
+	"duration":   types.Int64Type,
+	"timezone":   types.StringType,
+}
+
+type MaintenanceWindowModel struct {
+	Day       types.String `tfsdk:"day"`
+	StartTime types.String `tfsdk:"start_time"`
+	Duration  types.Int64  `tfsdk:"duration"`
+	Timezone  types.String `tfsdk:"timezone"`
+}
+
+func validateTimezone(timezone string) error {
+	// time.LoadLocation maps "" and "Local" to the machine timezone, which means nothing to the API
+	if timezone == "" || timezone == "Local" {
+		return fmt.Errorf("timezone should be an IANA timezone name such as \"Europe/Zurich\"")
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("unknown timezone %q", timezone)
+	}
+	return nil
+}
+
+type kubernetesVersion struct {
+	major, minor, patch int
+	hasPatch            bool
+}
+
+func parseKubernetesVersion(version string) (kubernetesVersion, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return kubernetesVersion{}, fmt.Errorf("kubernetes version %q should be major.minor or major.minor.patch", version)
+	}
+
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return kubernetesVersion{}, fmt.Errorf("kubernetes version %q should be made of numbers", version)
+		}
+		numbers[i] = number
+	}
+
+	parsed := kubernetesVersion{major: numbers[0], minor: numbers[1]}
+	if len(numbers) == 3 {
+		parsed.patch = numbers[2]
+		parsed.hasPatch = true
+	}
+	return parsed, nil
+}
+
+// isAutoUpgradeOf tells whether running is a version the platform may have moved configured to on its own
+func isAutoUpgradeOf(configured, running, policy string) bool {
+	if policy != autoUpgradePatch && policy != autoUpgradeMinor {
+		return false
+	}
+
+	from, err := parseKubernetesVersion(configured)
+	if err != nil {
+		return false
+	}
+	to, err := parseKubernetesVersion(running)
+	if err != nil {
+		return false
+	}
+
+	if from.major != to.major {
+		return false
+	}
+	if to.minor > from.minor {
+		return policy == autoUpgradeMinor
+	}
+	if to.minor < from.minor {
+		return false
+	}
+	return !from.hasPatch || (to.hasPatch && to.patch >= from.patch)
+}
+
+// kubernetesVersionValue keeps the configured version while the cluster only diverged from it through
+// automatic upgrades, so kubernetes_version does not show a diff after each platform upgrade
+func kubernetesVersionValue(configured types.String, running, policy string) types.String {
+	if configured.IsNull() || configured.IsUnknown() {
+		return types.StringValue(running)
+	}
+	if configured.ValueString() == running || isAutoUpgradeOf(configured.ValueString(), running, policy) {
+		return configured
+	}
+	return types.StringValue(running)
+}
@@ -0,0 +1,46 @@
+package kaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("validateTimezone",
+	func(timezone string, matcher OmegaMatcher) {
+		Expect(validateTimezone(timezone)).To(matcher)
+	},
+	Entry("utc", "UTC", Succeed()),
+	Entry("iana", "Europe/Zurich", Succeed()),
+	Entry("empty", "", MatchError(ContainSubstring("IANA timezone name"))),
+	Entry("local", "Local", MatchError(ContainSubstring("IANA timezone name"))),
+	Entry("unknown", "Europe/Nowhere", MatchError(ContainSubstring("unknown timezone"))),
+)
+
+var _ = DescribeTable("isAutoUpgradeOf",
+	func(configured string, running string, policy string, expected bool) {
+		Expect(isAutoUpgradeOf(configured, running, policy)).To(Equal(expected))
+	},
+	Entry("none.patch", "1.30.1", "1.30.2", autoUpgradeNone, false),
+	Entry("patch.patch", "1.30.1", "1.30.2", autoUpgradePatch, true),
+	Entry("patch.minor_only", "1.30", "1.30.4", autoUpgradePatch, true),
+	Entry("patch.downgrade", "1.30.2", "1.30.1", autoUpgradePatch, false),
+	Entry("patch.missing_patch", "1.30.2", "1.30", autoUpgradePatch, false),
+	Entry("patch.minor", "1.30.2", "1.31.0", autoUpgradePatch, false),
+	Entry("minor.minor", "1.30.2", "1.31.0", autoUpgradeMinor, true),
+	Entry("minor.patch", "1.30", "1.30.7", autoUpgradeMinor, true),
+	Entry("minor.minor_downgrade", "1.31", "1.30.7", autoUpgradeMinor, false),
+	Entry("minor.major", "1.31", "2.0", autoUpgradeMinor, false),
+	Entry("patch.invalid_running", "1.30", "latest", autoUpgradePatch, false),
+	Entry("patch.invalid_config", "1", "1.30.1", autoUpgradePatch, false),
+	Entry("patch.v_prefix_running", "1.30", "v1.30.3", autoUpgradePatch, true),
+)
+
+var _ = DescribeTable("kubernetesVersionValue",
+	func(configured types.String, running string, expected string) {
+		Expect(kubernetesVersionValue(configured, running, autoUpgradePatch).ValueString()).To(Equal(expected))
+	},
+	Entry("imported version is the running one", types.StringNull(), "1.30.2", "1.30.2"),
+	Entry("automatic patch upgrade keeps the configured version", types.StringValue("1.30"), "1.30.2", "1.30"),
+	Entry("unexpected upgrade shows as drift", types.StringValue("1.30"), "1.31.0", "1.31.0"),
+)
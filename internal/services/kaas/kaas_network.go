@@ -0,0 +1,99 @@
+package kaas
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"terraform-provider-infomaniak/internal/apis/kaas"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type KaasNetworkModel struct {
+	PodCidr     types.String `tfsdk:"pod_cidr"`
+	ServiceCidr types.String `tfsdk:"service_cidr"`
+	NetworkId   types.String `tfsdk:"network_id"`
+	SubnetId    types.String `tfsdk:"subnet_id"`
+}
+
+// parseNetworkCidr only accepts masked prefixes, the API stores them masked
+func parseNetworkCidr(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if prefix != prefix.Masked() {
+		return netip.Prefix{}, fmt.Errorf("%s has host bits set, use %s instead", prefix, prefix.Masked())
+	}
+	return prefix, nil
+}
+
+func validateNetworkConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	networkPath := path.Root("network")
+
+	var networkObject types.Object
+	diags := config.GetAttribute(ctx, networkPath, &networkObject)
+	if diags.HasError() || networkObject.IsNull() || networkObject.IsUnknown() {
+		return diags
+	}
+
+	var network KaasNetworkModel
+	diags.Append(networkObject.As(ctx, &network, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	cidrs := make(map[string]netip.Prefix)
+	for name, value := range map[string]types.String{"pod_cidr": network.PodCidr, "service_cidr": network.ServiceCidr} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		prefix, err := parseNetworkCidr(value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(networkPath.AtName(name), "Invalid network configuration", err.Error())
+			continue
+		}
+		cidrs[name] = prefix
+	}
+
+	pod, hasPod := cidrs["pod_cidr"]
+	service, hasService := cidrs["service_cidr"]
+	if hasPod && hasService && pod.Overlaps(service) {
+		diags.AddAttributeError(
+			networkPath.AtName("service_cidr"),
+			"Invalid network configuration",
+			fmt.Sprintf("service_cidr %s overlaps pod_cidr %s", service, pod),
+		)
+	}
+
+	return diags
+}
+
+func (model *KaasModel) networkInput() *kaas.KaasNetwork {
+	if model.Network == nil {
+		return nil
+	}
+	return &kaas.KaasNetwork{
+		PodCidr:     model.Network.PodCidr.ValueString(),
+		ServiceCidr: model.Network.ServiceCidr.ValueString(),
+		NetworkId:   model.Network.NetworkId.ValueStringPointer(),
+		SubnetId:    model.Network.SubnetId.ValueStringPointer(),
+	}
+}
+
+// fillNetwork only tracks the network block when it is configured, clusters created without it use the platform defaults
+func (model *KaasModel) fillNetwork(network *kaas.KaasNetwork) {
+	if model.Network == nil || network == nil {
+		return
+	}
+	model.Network = &KaasNetworkModel{
+		PodCidr:     types.StringValue(network.PodCidr),
+		ServiceCidr: types.StringValue(network.ServiceCidr),
+		NetworkId:   types.StringPointerValue(network.NetworkId),
+		SubnetId:    types.StringPointerValue(network.SubnetId),
+	}
+}
@@ -0,0 +1,17 @@
+package kaas
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("parseNetworkCidr",
+	func(cidr string, matcher OmegaMatcher) {
+		_, err := parseNetworkCidr(cidr)
+		Expect(err).To(matcher)
+	},
+	Entry("ipv4", "10.100.0.0/16", Succeed()),
+	Entry("ipv6", "fd00:10::/64", Succeed()),
+	Entry("host_bits", "10.100.0.1/16", MatchError(ContainSubstring("use 10.100.0.0/16 instead"))),
+	Entry("garbage", "10.100.0.0", MatchError(ContainSubstring("no '/'"))),
+)
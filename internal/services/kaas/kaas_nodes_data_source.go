@@ -0,0 +1,119 @@
+package kaas
+
+import (
+	"context"
+	"terraform-provider-infomaniak/internal/apis"
+	"terraform-provider-infomaniak/internal/apis/kaas"
+	"terraform-provider-infomaniak/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &kaasNodesDataSource{}
+	_ datasource.DataSourceWithConfigure = &kaasNodesDataSource{}
+)
+
+type kaasNodesDataSource struct {
+	client *apis.Client
+}
+
+type KaasNodesModel struct {
+	PublicCloudId        types.Int64 `tfsdk:"public_cloud_id"`
+	PublicCloudProjectId types.Int64 `tfsdk:"public_cloud_project_id"`
+	KaasId               types.Int64 `tfsdk:"kaas_id"`
+	InstancePoolId       types.Int64 `tfsdk:"instance_pool_id"`
+
+	Nodes []KaasNodeModel `tfsdk:"nodes"`
+}
+
+type KaasNodeModel struct {
+	Name              types.String `tfsdk:"name"`
+	InstancePoolId    types.Int64  `tfsdk:"instance_pool_id"`
+	Status            types.String `tfsdk:"status"`
+	FlavorName        types.String `tfsdk:"flavor_name"`
+	AvailabilityZone  types.String `tfsdk:"availability_zone"`
+	PrivateIp         types.String `tfsdk:"private_ip"`
+	PublicIp          types.String `tfsdk:"public_ip"`
+	KubernetesVersion types.String `tfsdk:"kubernetes_version"`
+}
+
+func (model *KaasNodeModel) fill(node *kaas.Node) {
+	model.Name = types.StringValue(node.Name)
+	model.InstancePoolId = types.Int64Value(node.InstancePoolId)
+	model.Status = types.StringValue(node.Status)
+	model.FlavorName = types.StringValue(node.FlavorName)
+	model.AvailabilityZone = types.StringValue(node.AvailabilityZone)
+	model.PrivateIp = types.StringValue(node.PrivateIp)
+	model.PublicIp = types.StringValue(node.PublicIp)
+	model.KubernetesVersion = types.StringValue(node.KubernetesVersion)
+}
+
+// NewKaasNodesDataSource is a helper function to simplify the provider implementation.
+func NewKaasNodesDataSource() datasource.DataSource {
+	return &kaasNodesDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *kaasNodesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := provider.GetApiClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *kaasNodesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = getKaasNodesDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *kaasNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KaasNodesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := d.client.Kaas.GetNodes(
+		data.PublicCloudId.ValueInt64(),
+		data.PublicCloudProjectId.ValueInt64(),
+		data.KaasId.ValueInt64(),
+		data.InstancePoolId.ValueInt64Pointer(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list KaaS nodes",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Nodes = make([]KaasNodeModel, len(nodes))
+	for i, node := range nodes {
+		data.Nodes[i].fill(node)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Metadata returns the data source type name.
+func (d *kaasNodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kaas_nodes"
+}
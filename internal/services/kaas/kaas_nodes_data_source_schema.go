@@ -0,0 +1,69 @@
+package kaas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func getKaasNodesDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"public_cloud_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The id of the public cloud where KaaS is installed",
+			},
+			"public_cloud_project_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The id of the public cloud project where KaaS is installed",
+			},
+			"kaas_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The id of the kaas project.",
+			},
+			"instance_pool_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only list the nodes belonging to this instance pool.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The nodes backing the instance pools of the KaaS project",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the node",
+						},
+						"instance_pool_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The id of the instance pool the node belongs to",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the node",
+						},
+						"flavor_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The flavor name of the node",
+						},
+						"availability_zone": schema.StringAttribute{
+							Computed:    true,
+							Description: "The availability zone where the node resides",
+						},
+						"private_ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "The private IP address of the node",
+						},
+						"public_ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "The public IP address of the node, empty when the node has none",
+						},
+						"kubernetes_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The version of the kubelet running on the node",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "The KaaS Nodes data source lists the instances backing the instance pools of a KaaS project.",
+	}
+}
@@ -0,0 +1,43 @@
+package kaas
+
+import (
+	"regexp"
+	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestKaasNodesDatasource_Schema(t *testing.T) {
+	testCases := map[string]resource.TestCase{
+		"data_source.kaas_nodes.good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "data_source_kaas_nodes_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.infomaniak_kaas_nodes.nodes", "nodes.#", "3"),
+						resource.TestCheckResourceAttr("data.infomaniak_kaas_nodes.nodes", "nodes.0.flavor_name", "test"),
+						resource.TestCheckResourceAttrSet("data.infomaniak_kaas_nodes.nodes", "nodes.0.private_ip"),
+					),
+				},
+			},
+		},
+		"data_source.kaas_nodes.missing_kaas_id": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "data_source_kaas_nodes_missing_kaas_id.tf"),
+					ExpectError: regexp.MustCompile(`The argument "kaas_id" is required, but no definition was found.`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, tc)
+		})
+	}
+}
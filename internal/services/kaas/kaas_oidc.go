@@ -0,0 +1,87 @@
+package kaas
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// oidcSigningAlgs are the JOSE algorithms supported by the Apiserver to verify ID tokens
+var oidcSigningAlgs = []string{
+	"RS256", "RS384", "RS512",
+	"ES256", "ES384", "ES512",
+	"PS256", "PS384", "PS512",
+}
+
+// oidcParamFlags are the Apiserver flags already handled by the oidc block, they can't be set through params
+var oidcParamFlags = []string{
+	"oidc-issuer-url",
+	"oidc-client-id",
+	"oidc-username-claim",
+	"oidc-username-prefix",
+	"oidc-signing-algs",
+	"oidc-groups-claim",
+	"oidc-groups-prefix",
+	"oidc-required-claim",
+	"oidc-ca-file",
+}
+
+func validateOidcIssuerUrl(issuerUrl string) error {
+	parsed, err := url.Parse(issuerUrl)
+	if err != nil {
+		return fmt.Errorf("could not parse issuer url: %w", err)
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("issuer url should use the https scheme, got %q", parsed.Scheme)
+	}
+	if parsed.Hostname() == "" {
+		return fmt.Errorf("issuer url should have a host")
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return fmt.Errorf("issuer url should not have a query or a fragment")
+	}
+	return nil
+}
+
+func validateOidcSigningAlgs(signingAlgs string) error {
+	for alg := range strings.SplitSeq(signingAlgs, ",") {
+		alg = strings.TrimSpace(alg)
+		if !slices.Contains(oidcSigningAlgs, alg) {
+			return fmt.Errorf("signing algorithm %q is not supported, it should be one of %v", alg, oidcSigningAlgs)
+		}
+	}
+	return nil
+}
+
+// validateOidcCa makes sure the CA is a PEM bundle made only of valid certificates
+func validateOidcCa(ca string) error {
+	rest := []byte(strings.TrimSpace(ca))
+	count := 0
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return fmt.Errorf("ca should only contain PEM encoded certificates")
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("ca contains a %q PEM block, only certificates are allowed", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("could not parse certificate %d of ca: %w", count, err)
+		}
+		rest = []byte(strings.TrimSpace(string(rest)))
+		count++
+	}
+	if count == 0 {
+		return fmt.Errorf("ca should contain at least one certificate")
+	}
+	return nil
+}
+
+// isOidcParam reports whether an Apiserver params key targets a flag of the oidc block, with or without leading dashes
+func isOidcParam(key string) bool {
+	return slices.Contains(oidcParamFlags, strings.TrimLeft(strings.TrimSpace(key), "-"))
+}
@@ -10,19 +10,25 @@ import (
 	"terraform-provider-infomaniak/internal/apis"
 	"terraform-provider-infomaniak/internal/apis/kaas"
 	"terraform-provider-infomaniak/internal/provider"
+	"terraform-provider-infomaniak/internal/utils"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource                = &kaasResource{}
-	_ resource.ResourceWithConfigure   = &kaasResource{}
-	_ resource.ResourceWithImportState = &kaasResource{}
+	_ resource.Resource                   = &kaasResource{}
+	_ resource.ResourceWithConfigure      = &kaasResource{}
+	_ resource.ResourceWithImportState    = &kaasResource{}
+	_ resource.ResourceWithValidateConfig = &kaasResource{}
+	_ resource.ResourceWithModifyPlan     = &kaasResource{}
 )
 
 func NewKaasResource() resource.Resource {
@@ -30,7 +36,8 @@ func NewKaasResource() resource.Resource {
 }
 
 type kaasResource struct {
-	client *apis.Client
+	client      *apis.Client
+	defaultTags map[string]string
 }
 
 type KaasModel struct {
@@ -44,6 +51,27 @@ type KaasModel struct {
 	Kubeconfig        types.String    `tfsdk:"kubeconfig"`
 	KubernetesVersion types.String    `tfsdk:"kubernetes_version"`
 	Apiserver         *ApiserverModel `tfsdk:"apiserver"`
+
+	Network                  *KaasNetworkModel `tfsdk:"network"`
+	CurrentKubernetesVersion types.String      `tfsdk:"current_kubernetes_version"`
+	MaintenanceWindow        types.Object      `tfsdk:"maintenance_window"`
+	AutoUpgrade              types.String      `tfsdk:"auto_upgrade"`
+
+	Status            types.String `tfsdk:"status"`
+	ApiserverEndpoint types.String `tfsdk:"apiserver_endpoint"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+
+	Tags    types.Map `tfsdk:"tags"`
+	TagsAll types.Map `tfsdk:"tags_all"`
+}
+
+// kaasResourceModel adds the attributes only known by the resource to the ones shared with the data source
+type kaasResourceModel struct {
+	KaasModel
+
+	ExternalIpFilters types.Bool      `tfsdk:"external_ip_filters"`
+	Timeouts          *utils.Timeouts `tfsdk:"timeouts"`
 }
 
 func (m *KaasModel) SetDefaultValues(ctx context.Context) {
@@ -81,8 +109,9 @@ type OidcModel struct {
 }
 
 type Audit struct {
-	WebhookConfig types.String `tfsdk:"webhook_config"`
-	Policy        types.String `tfsdk:"policy"`
+	WebhookConfig types.String           `tfsdk:"webhook_config"`
+	Policy        types.String           `tfsdk:"policy"`
+	PolicyRules   []AuditPolicyRuleModel `tfsdk:"policy_rules"`
 }
 
 func (r *kaasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -106,15 +135,166 @@ func (r *kaasResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
+	defaultTags, err := provider.GetDefaultTags(ctx, req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			err.Error(),
+		)
+		return
+	}
+
 	r.client = client
+	r.defaultTags = defaultTags
+}
+
+// ModifyPlan shows the effective tags, merged with the provider default_tags, in the plan
+func (r *kaasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := utils.MergeTags(ctx, r.defaultTags, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
 }
 
 func (r *kaasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = getKaasResourceSchema()
 }
 
+func (r *kaasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateAuditPolicyConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateOidcConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateApiserverParamsConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateMaintenanceWindowConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateNetworkConfig(ctx, req.Config)...)
+	resp.Diagnostics.Append(validateIpFiltersConfig(ctx, req.Config)...)
+}
+
+// validateIpFiltersConfig rejects inline ip filters when they are left to infomaniak_kaas_ip_filter resources
+func validateIpFiltersConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	ipFiltersPath := path.Root("apiserver").AtName("ip_filters")
+
+	var external types.Bool
+	var ipFilters types.List
+	diags := config.GetAttribute(ctx, path.Root("external_ip_filters"), &external)
+	diags.Append(config.GetAttribute(ctx, ipFiltersPath, &ipFilters)...)
+	if diags.HasError() || !external.ValueBool() || ipFilters.IsNull() {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		ipFiltersPath,
+		"Invalid IP filters configuration",
+		"apiserver.ip_filters cannot be set when external_ip_filters is true, the IP filters are managed by infomaniak_kaas_ip_filter resources",
+	)
+	return diags
+}
+
+func validateMaintenanceWindowConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	timezonePath := path.Root("maintenance_window").AtName("timezone")
+
+	var timezone types.String
+	diags := config.GetAttribute(ctx, timezonePath, &timezone)
+	if diags.HasError() || timezone.IsNull() || timezone.IsUnknown() {
+		return diags
+	}
+
+	if err := validateTimezone(timezone.ValueString()); err != nil {
+		diags.AddAttributeError(timezonePath, "Invalid maintenance window", err.Error())
+	}
+
+	return diags
+}
+
+func validateAuditPolicyConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	policyPath := path.Root("apiserver").AtName("audit").AtName("policy")
+
+	var policy types.String
+	diags := config.GetAttribute(ctx, policyPath, &policy)
+	if diags.HasError() || policy.IsNull() || policy.IsUnknown() {
+		return diags
+	}
+
+	if _, err := parseAuditPolicy(policy.ValueString()); err != nil {
+		diags.AddAttributeError(policyPath, "Invalid audit policy", err.Error())
+	}
+	return diags
+}
+
+func validateOidcConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	apiserverPath := path.Root("apiserver")
+	oidcPath := apiserverPath.AtName("oidc")
+
+	var params types.Map
+	diags := config.GetAttribute(ctx, apiserverPath.AtName("params"), &params)
+	if diags.HasError() {
+		return diags
+	}
+	for key := range params.Elements() {
+		if isOidcParam(key) {
+			diags.AddAttributeError(
+				apiserverPath.AtName("params").AtMapKey(key),
+				"Invalid Apiserver param",
+				fmt.Sprintf("%s is managed by the oidc block, set it there instead", key),
+			)
+		}
+	}
+
+	var oidcObject types.Object
+	diags.Append(config.GetAttribute(ctx, oidcPath, &oidcObject)...)
+	if diags.HasError() || oidcObject.IsNull() || oidcObject.IsUnknown() {
+		return diags
+	}
+
+	var oidc OidcModel
+	diags.Append(oidcObject.As(ctx, &oidc, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if oidc.IssuerUrl.IsNull() != oidc.ClientId.IsNull() {
+		diags.AddAttributeError(
+			oidcPath,
+			"Invalid OIDC configuration",
+			"issuer_url and client_id should be set together",
+		)
+	}
+
+	checks := []struct {
+		name     string
+		value    types.String
+		validate func(string) error
+	}{
+		{"issuer_url", oidc.IssuerUrl, validateOidcIssuerUrl},
+		{"signing_algs", oidc.SigningAlgs, validateOidcSigningAlgs},
+		{"ca", oidc.Ca, validateOidcCa},
+	}
+	for _, check := range checks {
+		if check.value.IsNull() || check.value.IsUnknown() {
+			continue
+		}
+		if err := check.validate(check.value.ValueString()); err != nil {
+			diags.AddAttributeError(oidcPath.AtName(check.name), "Invalid OIDC configuration", err.Error())
+		}
+	}
+
+	return diags
+}
+
 func (r *kaasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data KaasModel
+	var data kaasResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -122,7 +302,7 @@ func (r *kaasResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	chosenPack, err := r.getPackId(data, &resp.Diagnostics)
+	chosenPack, err := r.getPackId(data.KaasModel, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -136,7 +316,19 @@ func (r *kaasResource) Create(ctx context.Context, req resource.CreateRequest, r
 		KubernetesVersion: data.KubernetesVersion.ValueString(),
 		Name:              data.Name.ValueString(),
 		PackId:            chosenPack.Id,
+		Network:           data.networkInput(),
+		AutoUpgrade:       data.AutoUpgrade.ValueString(),
+	}
+
+	maintenanceWindow, diags := data.maintenanceWindowInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	tags, diags := utils.TagsToApi(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	input.MaintenanceWindow = maintenanceWindow
+	input.Tags = tags
 
 	// CreateKaas API call logic
 	kaasId, err := r.client.Kaas.CreateKaas(input)
@@ -164,17 +356,23 @@ func (r *kaasResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	err = r.fetchAndSetKubeconfig(&data, kaasObject)
+	err = r.fetchAndSetKubeconfig(&data.KaasModel, kaasObject)
 	if err != nil {
 		resp.Diagnostics.AddWarning("could not fetch and set kubeconfig", err.Error())
 	}
 
 	data.fill(kaasObject)
+	data.fillTags(kaasObject.Tags, r.defaultTags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	if data.Apiserver != nil {
-		apiserverParamsInput := r.buildApiserverParamsInput(data)
+		apiserverParamsInput, diags := r.buildApiserverParamsInput(ctx, data.KaasModel)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		created, err := r.client.Kaas.PatchApiserverParams(apiserverParamsInput, input.Project.PublicCloudId, input.Project.ProjectId, kaasId)
 		if !created || err != nil {
 			resp.Diagnostics.AddError(
@@ -184,7 +382,7 @@ func (r *kaasResource) Create(ctx context.Context, req resource.CreateRequest, r
 			return
 		}
 
-		applyFiltersDiags := r.applyIPFilters(ctx, data.Apiserver.IpFilters, input.Project.PublicCloudId, input.Project.ProjectId, kaasId)
+		applyFiltersDiags := r.applyIPFilters(ctx, data, input.Project.PublicCloudId, input.Project.ProjectId, kaasId)
 		resp.Diagnostics.Append(applyFiltersDiags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -200,8 +398,9 @@ func (r *kaasResource) Create(ctx context.Context, req resource.CreateRequest, r
 func (state *KaasModel) fillApiserverState(ctx context.Context, apiserverParams *kaas.Apiserver) {
 	if state.shouldUpdateApiserver() {
 		state.SetDefaultValues(ctx)
-		state.updateAuditConfig(apiserverParams)
+		state.updateAuditConfig(ctx, apiserverParams)
 		state.updateOIDCConfig(apiserverParams)
+		state.Apiserver.Params = managedApiserverParams(state.Apiserver.Params, apiserverParams.NonSpecificApiServerParams)
 		if state.canSetApiserverToNil() {
 			state.Apiserver = nil
 		}
@@ -231,13 +430,27 @@ func (state *KaasModel) shouldUpdateApiserver() bool {
 	return apiserver != nil && (apiserver.Audit != nil || apiserver.Oidc != nil || !apiserver.Params.IsNull())
 }
 
-func (state *KaasModel) updateAuditConfig(apiserverParams *kaas.Apiserver) {
+func (state *KaasModel) updateAuditConfig(ctx context.Context, apiserverParams *kaas.Apiserver) {
 	if apiserverParams.AuditLogPolicy == nil && apiserverParams.AuditLogWebhook == nil {
 		state.Apiserver.Audit = nil
-	} else {
-		state.Apiserver.Audit.Policy = types.StringPointerValue(apiserverParams.AuditLogPolicy)
-		state.Apiserver.Audit.WebhookConfig = types.StringPointerValue(apiserverParams.AuditLogWebhook)
+		return
 	}
+
+	audit := state.Apiserver.Audit
+	audit.WebhookConfig = types.StringPointerValue(apiserverParams.AuditLogWebhook)
+	audit.Policy = types.StringPointerValue(apiserverParams.AuditLogPolicy)
+
+	// Keep the structured form when it is the one managed, the raw document is used as a fallback
+	// when the remote policy cannot be expressed with policy_rules so the drift is still visible
+	if audit.PolicyRules != nil && apiserverParams.AuditLogPolicy != nil {
+		rules, err := auditPolicyRulesFrom(ctx, *apiserverParams.AuditLogPolicy)
+		if err == nil {
+			audit.Policy = types.StringNull()
+			audit.PolicyRules = rules
+			return
+		}
+	}
+	audit.PolicyRules = nil
 }
 
 func (state *KaasModel) updateOIDCConfig(apiserverParams *kaas.Apiserver) {
@@ -298,7 +511,7 @@ func (r *kaasResource) getApiserverParamsValues(data KaasModel) map[string]strin
 }
 
 func (r *kaasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state KaasModel
+	var state kaasResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -322,8 +535,9 @@ func (r *kaasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	state.fill(kaasObject)
+	state.fillTags(kaasObject.Tags, r.defaultTags)
 
-	err = r.fetchAndSetKubeconfig(&state, kaasObject)
+	err = r.fetchAndSetKubeconfig(&state.KaasModel, kaasObject)
 	if err != nil {
 		resp.Diagnostics.AddWarning("could not fetch and set kubeconfig", err.Error())
 	}
@@ -340,7 +554,13 @@ func (r *kaasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.fillApiserverState(ctx, apiserverParams)
 	}
 
-	if state.Apiserver != nil {
+	// Imported clusters and states written by older versions manage their ip filters inline
+	if state.ExternalIpFilters.IsNull() {
+		state.ExternalIpFilters = types.BoolValue(false)
+	}
+
+	// ip filters managed by infomaniak_kaas_ip_filter resources are left alone
+	if state.Apiserver != nil && !state.ExternalIpFilters.ValueBool() {
 		ipFilters, err := r.client.Kaas.GetIPFilters(state.PublicCloudId.ValueInt64(), state.PublicCloudProjectId.ValueInt64(), kaasObject.Id)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -356,8 +576,8 @@ func (r *kaasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *kaasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state KaasModel
-	var data KaasModel
+	var state kaasResourceModel
+	var data kaasResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -366,12 +586,22 @@ func (r *kaasResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	chosenPackState, err := r.getPackId(state, &resp.Diagnostics)
+	chosenPackState, err := r.getPackId(state.KaasModel, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
 
-	input := r.prepareUpdateInput(state, data, chosenPackState.Id)
+	input := r.prepareUpdateInput(state.KaasModel, data.KaasModel, chosenPackState.Id)
+
+	maintenanceWindow, diags := data.maintenanceWindowInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	tags, diags := utils.TagsToApi(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.MaintenanceWindow = maintenanceWindow
+	input.Tags = tags
 
 	if _, err := r.client.Kaas.UpdateKaas(input); err != nil {
 		resp.Diagnostics.AddError("Error when updating KaaS", err.Error())
@@ -384,17 +614,18 @@ func (r *kaasResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	err = r.fetchAndSetKubeconfig(&data, kaasObject)
+	err = r.fetchAndSetKubeconfig(&data.KaasModel, kaasObject)
 	if err != nil {
 		resp.Diagnostics.AddWarning("could not fetch and set kubeconfig", err.Error())
 	}
 
 	data.fill(kaasObject)
+	data.fillTags(kaasObject.Tags, r.defaultTags)
 
 	if data.Apiserver != nil {
-		r.handleApiserverConfig(ctx, &data, input, resp)
+		r.handleApiserverConfig(ctx, &data.KaasModel, input, resp)
 
-		applyFiltersDiags := r.applyIPFilters(ctx, data.Apiserver.IpFilters, input.Project.PublicCloudId, input.Project.ProjectId, input.Id)
+		applyFiltersDiags := r.applyIPFilters(ctx, data, input.Project.PublicCloudId, input.Project.ProjectId, input.Id)
 		resp.Diagnostics.Append(applyFiltersDiags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -415,6 +646,7 @@ func (r *kaasResource) prepareUpdateInput(state, data KaasModel, packID int64) *
 		PackId:            packID,
 		Region:            state.Region.ValueString(),
 		KubernetesVersion: data.KubernetesVersion.ValueString(),
+		AutoUpgrade:       data.AutoUpgrade.ValueString(),
 	}
 
 	if state.KubernetesVersion.ValueString() == data.KubernetesVersion.ValueString() {
@@ -438,7 +670,12 @@ func (r *kaasResource) fetchAndSetKubeconfig(data *KaasModel, input *kaas.Kaas)
 }
 
 func (r *kaasResource) handleApiserverConfig(ctx context.Context, data *KaasModel, input *kaas.Kaas, resp *resource.UpdateResponse) {
-	apiserverParamsInput := r.buildApiserverParamsInput(*data)
+	apiserverParamsInput, diags := r.buildApiserverParamsInput(ctx, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patched, err := r.client.Kaas.PatchApiserverParams(apiserverParamsInput, input.Project.PublicCloudId, input.Project.ProjectId, input.Id)
 	if !patched || err != nil {
 		resp.Diagnostics.AddError("Error when patching Apiserver params", err.Error())
@@ -447,8 +684,15 @@ func (r *kaasResource) handleApiserverConfig(ctx context.Context, data *KaasMode
 	data.fillApiserverState(ctx, apiserverParamsInput)
 }
 
-func (r *kaasResource) applyIPFilters(ctx context.Context, terraformIpFilters types.List, publicCloudId, projectId, kaasId int64) diag.Diagnostics {
+// applyIPFilters replaces the ip filters of the cluster by the inline ones, no ip filters clears them.
+// They are left untouched when managed by infomaniak_kaas_ip_filter resources.
+func (r *kaasResource) applyIPFilters(ctx context.Context, data kaasResourceModel, publicCloudId, projectId, kaasId int64) diag.Diagnostics {
 	var diags diag.Diagnostics
+	if data.ExternalIpFilters.ValueBool() {
+		return diags
+	}
+
+	terraformIpFilters := data.Apiserver.IpFilters
 	ipFilters := make([]string, 0, len(terraformIpFilters.Elements()))
 	diags.Append(terraformIpFilters.ElementsAs(ctx, &ipFilters, true)...)
 	if diags.HasError() {
@@ -466,6 +710,9 @@ func (r *kaasResource) applyIPFilters(ctx context.Context, terraformIpFilters ty
 		convertedIpFilters[i] = prefix
 	}
 
+	unlock := lockIPFilters(publicCloudId, projectId, kaasId)
+	defer unlock()
+
 	ok, err := r.client.Kaas.PutIPFilters(convertedIpFilters, publicCloudId, projectId, kaasId)
 	if !ok || err != nil {
 		var errMsg string
@@ -480,13 +727,19 @@ func (r *kaasResource) applyIPFilters(ctx context.Context, terraformIpFilters ty
 	return diags
 }
 
-func (r *kaasResource) buildApiserverParamsInput(data KaasModel) *kaas.Apiserver {
+func (r *kaasResource) buildApiserverParamsInput(ctx context.Context, data KaasModel) (*kaas.Apiserver, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	apiserverParamsInput := &kaas.Apiserver{
 		NonSpecificApiServerParams: r.getApiserverParamsValues(data),
 	}
 	if data.Apiserver.Audit != nil {
 		apiserverParamsInput.AuditLogPolicy = data.Apiserver.Audit.Policy.ValueStringPointer()
 		apiserverParamsInput.AuditLogWebhook = data.Apiserver.Audit.WebhookConfig.ValueStringPointer()
+		if data.Apiserver.Audit.PolicyRules != nil {
+			policy, renderDiags := renderAuditPolicy(ctx, data.Apiserver.Audit.PolicyRules)
+			diags.Append(renderDiags...)
+			apiserverParamsInput.AuditLogPolicy = &policy
+		}
 	}
 	if data.Apiserver.Oidc != nil {
 		apiserverParamsInput.OidcCa = data.Apiserver.Oidc.Ca.ValueStringPointer()
@@ -501,11 +754,11 @@ func (r *kaasResource) buildApiserverParamsInput(data KaasModel) *kaas.Apiserver
 			RequiredClaim:  data.Apiserver.Oidc.RequiredClaim.ValueStringPointer(),
 		}
 	}
-	return apiserverParamsInput
+	return apiserverParamsInput, diags
 }
 
 func (r *kaasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data KaasModel
+	var data kaasResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -527,6 +780,21 @@ func (r *kaasResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		)
 		return
 	}
+
+	err = utils.WaitUntilDeleted(ctx, data.Timeouts.DeleteTimeout(), func() (bool, error) {
+		found, err := r.client.Kaas.GetKaas(data.PublicCloudId.ValueInt64(), data.PublicCloudProjectId.ValueInt64(), data.Id.ValueInt64())
+		if err != nil {
+			return false, err
+		}
+		return found.Status == "Deleted", nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error when waiting for KaaS to be deleted",
+			err.Error(),
+		)
+		return
+	}
 }
 
 func (r *kaasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -599,7 +867,58 @@ func (r *kaasResource) getPackId(data KaasModel, diagnostic *diag.Diagnostics) (
 func (model *KaasModel) fill(kaas *kaas.Kaas) {
 	model.Id = types.Int64Value(kaas.Id)
 	model.Region = types.StringValue(kaas.Region)
-	model.KubernetesVersion = types.StringValue(kaas.KubernetesVersion)
+	model.KubernetesVersion = kubernetesVersionValue(model.KubernetesVersion, kaas.KubernetesVersion, kaas.AutoUpgrade)
 	model.Name = types.StringValue(kaas.Name)
 	model.PackName = types.StringValue(kaas.Pack.Name)
+	model.fillStatus(kaas)
+	model.fillMaintenance(kaas)
+	model.fillNetwork(kaas.Network)
+}
+
+func (model *KaasModel) fillMaintenance(kaas *kaas.Kaas) {
+	model.CurrentKubernetesVersion = types.StringValue(kaas.KubernetesVersion)
+	if kaas.AutoUpgrade != "" {
+		model.AutoUpgrade = types.StringValue(kaas.AutoUpgrade)
+	} else {
+		model.AutoUpgrade = types.StringValue(autoUpgradeNone)
+	}
+
+	// The window is always read back, a removed block keeps the current window instead of clearing it
+	if kaas.MaintenanceWindow == nil {
+		model.MaintenanceWindow = types.ObjectNull(maintenanceWindowAttributeTypes)
+		return
+	}
+	model.MaintenanceWindow = types.ObjectValueMust(maintenanceWindowAttributeTypes, map[string]attr.Value{
+		"day":        types.StringValue(kaas.MaintenanceWindow.Day),
+		"start_time": types.StringValue(kaas.MaintenanceWindow.StartTime),
+		"duration":   types.Int64Value(kaas.MaintenanceWindow.Duration),
+		"timezone":   types.StringValue(kaas.MaintenanceWindow.Timezone),
+	})
+}
+
+// maintenanceWindowInput is nil when the block is not configured, the API then keeps the current window
+func (model *KaasModel) maintenanceWindowInput(ctx context.Context) (*kaas.MaintenanceWindow, diag.Diagnostics) {
+	if model.MaintenanceWindow.IsNull() || model.MaintenanceWindow.IsUnknown() {
+		return nil, nil
+	}
+
+	var window MaintenanceWindowModel
+	diags := model.MaintenanceWindow.As(ctx, &window, basetypes.ObjectAsOptions{})
+	return &kaas.MaintenanceWindow{
+		Day:       window.Day.ValueString(),
+		StartTime: window.StartTime.ValueString(),
+		Duration:  window.Duration.ValueInt64(),
+		Timezone:  window.Timezone.ValueString(),
+	}, diags
+}
+
+func (model *KaasModel) fillTags(apiTags map[string]string, defaultTags map[string]string) {
+	model.Tags, model.TagsAll = utils.TagsFromApi(apiTags, defaultTags, model.Tags)
+}
+
+func (model *KaasModel) fillStatus(kaas *kaas.Kaas) {
+	model.Status = types.StringValue(kaas.Status)
+	model.ApiserverEndpoint = types.StringValue(kaas.ApiserverEndpoint)
+	model.CreatedAt = utils.TimestampValue(kaas.CreatedAt)
+	model.UpdatedAt = utils.TimestampValue(kaas.UpdatedAt)
 }
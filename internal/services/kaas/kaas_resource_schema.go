@@ -1,13 +1,23 @@
 package kaas
 
 import (
+	"terraform-provider-infomaniak/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -78,6 +88,133 @@ func getKaasResourceSchema() schema.Schema {
 				Description:         "The kubeconfig generated to access to KaaS project",
 				MarkdownDescription: "The kubeconfig generated to access to KaaS project",
 			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the KaaS, `Active` once the cluster is ready",
+			},
+			"apiserver_endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the Kubernetes Apiserver",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The creation date of the KaaS, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date of the last update of the KaaS, in RFC 3339 format",
+			},
+			"network": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The networking options of the cluster, they can only be set at creation",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"pod_cidr": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The CIDR block the pod IPs are allocated from, chosen by the platform when not set",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"service_cidr": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The CIDR block the service IPs are allocated from, chosen by the platform when not set",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"network_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The id of an existing private network of the public cloud project to attach the cluster to",
+					},
+					"subnet_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The id of the subnet of `network_id` the nodes are attached to",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("network_id")),
+						},
+					},
+				},
+			},
+			"current_kubernetes_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of Kubernetes running on the KaaS, it can be ahead of `kubernetes_version` after automatic upgrades",
+			},
+			"auto_upgrade": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(autoUpgradeNone),
+				MarkdownDescription: "The versions the platform may upgrade the control plane to on its own during the maintenance window, one of `none`, `patch` or `minor`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(autoUpgradePolicies...),
+				},
+			},
+			"external_ip_filters": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Leave the IP filters of the cluster to `infomaniak_kaas_ip_filter` resources instead of `apiserver.ip_filters`, which must then be unset",
+			},
+			"timeouts": utils.TimeoutsAttribute(),
+			"maintenance_window": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The weekly slot during which the control plane may be upgraded, removing the block keeps the current window",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"day": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The day of the week the maintenance window starts, in lowercase",
+						Validators: []validator.String{
+							stringvalidator.OneOf(maintenanceDays...),
+						},
+					},
+					"start_time": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The time the maintenance window starts, in `HH:MM` format",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(maintenanceStartTimeRegexp, "should be a time in HH:MM format"),
+						},
+					},
+					"duration": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(4),
+						MarkdownDescription: "The length of the maintenance window in hours, between 1 and 24",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 24),
+						},
+					},
+					"timezone": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("UTC"),
+						MarkdownDescription: "The IANA timezone of `start_time`",
+					},
+				},
+			},
+			"tags": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the KaaS, they take precedence over the provider `default_tags`",
+			},
+			"tags_all": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "All the tags of the KaaS, including the provider `default_tags`",
+			},
 			"apiserver": schema.SingleNestedAttribute{
 				MarkdownDescription: "Kubernetes Apiserver editable params",
 				Optional:            true,
@@ -113,11 +250,64 @@ func getKaasResourceSchema() schema.Schema {
 								},
 							},
 							"policy": schema.StringAttribute{
-								MarkdownDescription: "YAML manifest for audit policy",
+								MarkdownDescription: "YAML manifest for audit policy, it is validated against the `audit.k8s.io/v1` Policy format at plan time",
 								Optional:            true,
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("policy_rules")),
+								},
+							},
+							"policy_rules": schema.ListNestedAttribute{
+								MarkdownDescription: "Structured audit policy rules, rendered to an `audit.k8s.io/v1` Policy document. Rules are evaluated in order and the first matching rule sets the audit level of the event",
+								Optional:            true,
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"level": schema.StringAttribute{
+											Required:            true,
+											MarkdownDescription: "The audit level of the events matching this rule. One of `None`, `Metadata`, `Request` or `RequestResponse`",
+											Validators: []validator.String{
+												stringvalidator.OneOf(auditLevels...),
+											},
+										},
+										"users":      auditPolicyStringList("Users this rule applies to"),
+										"groups":     auditPolicyStringList("User groups this rule applies to"),
+										"verbs":      auditPolicyStringList("Verbs this rule applies to"),
+										"namespaces": auditPolicyStringList("Namespaces this rule applies to"),
+										"omit_stages": schema.ListAttribute{
+											Optional:            true,
+											ElementType:         types.StringType,
+											MarkdownDescription: "Stages for which no event is generated. Any of `RequestReceived`, `ResponseStarted`, `ResponseComplete` or `Panic`",
+											Validators: []validator.List{
+												listvalidator.SizeAtLeast(1),
+												listvalidator.ValueStringsAre(stringvalidator.OneOf(auditStages...)),
+											},
+										},
+										"resources": schema.ListNestedAttribute{
+											Optional:            true,
+											MarkdownDescription: "Resources this rule applies to",
+											Validators: []validator.List{
+												listvalidator.SizeAtLeast(1),
+											},
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"group": schema.StringAttribute{
+														Optional:            true,
+														Computed:            true,
+														Default:             stringdefault.StaticString(""),
+														MarkdownDescription: "API group of the resources, defaults to the core group",
+													},
+													"resources":      auditPolicyStringList("Resources of the group this rule applies to, `*` matches all of them"),
+													"resource_names": auditPolicyStringList("Names of the resources this rule applies to"),
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -196,3 +386,14 @@ func getKaasResourceSchema() schema.Schema {
 		MarkdownDescription: "The kaas resource allows the user to manage a kaas project",
 	}
 }
+
+func auditPolicyStringList(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: description,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+}
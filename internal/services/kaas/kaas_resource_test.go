@@ -11,8 +11,28 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+// checkMockedIpFilters ensures the cluster held by the mock has the expected number of ip filters
+func checkMockedIpFilters(name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ids, _, err := test.GetIdsFromState(s, name, "public_cloud_id", "public_cloud_project_id", "id")
+		if err != nil {
+			return err
+		}
+
+		ipFilters, err := (&mockKaas.Client{}).GetIPFilters(ids[0], ids[1], ids[2])
+		if err != nil {
+			return err
+		}
+		if len(ipFilters) != expected {
+			return fmt.Errorf("expected %d ip filters, got %d", expected, len(ipFilters))
+		}
+		return nil
+	}
+}
+
 func TestKaasResource_Schema(t *testing.T) {
 
 	testCases := map[string]resource.TestCase{
@@ -25,6 +45,9 @@ func TestKaasResource_Schema(t *testing.T) {
 						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "region", "dc5"),
 						resource.TestCheckResourceAttrSet("infomaniak_kaas.kluster", "id"),
 						resource.TestCheckResourceAttrSet("infomaniak_kaas.kluster", "kubeconfig"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "status", "Active"),
+						resource.TestCheckResourceAttrSet("infomaniak_kaas.kluster", "apiserver_endpoint"),
+						resource.TestCheckResourceAttrSet("infomaniak_kaas.kluster", "created_at"),
 					),
 				},
 			},
@@ -56,6 +79,203 @@ func TestKaasResource_Schema(t *testing.T) {
 				},
 			},
 		},
+		"resource.kaas.audit_policy_rules_good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_kaas_audit_policy_rules_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "apiserver.audit.policy_rules.#", "2"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "apiserver.audit.policy_rules.0.resources.0.group", ""),
+						resource.TestCheckNoResourceAttr("infomaniak_kaas.kluster", "apiserver.audit.policy"),
+					),
+				},
+			},
+		},
+		"resource.kaas.audit_policy_invalid": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_audit_policy_invalid.tf"),
+					ExpectError: regexp.MustCompile(`rules\[0\]\.level should be one of`),
+				},
+			},
+		},
+		"resource.kaas.audit_policy_conflict": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_audit_policy_conflict.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		},
+		"resource.kaas.tags": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_kaas_tags.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "tags.%", "2"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "tags_all.%", "3"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "tags_all.team", "platform"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "tags_all.env", "staging"),
+					),
+				},
+			},
+		},
+		"resource.kaas.maintenance_good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_kaas_maintenance_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "auto_upgrade", "patch"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "maintenance_window.day", "tuesday"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "maintenance_window.duration", "4"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "maintenance_window.timezone", "Europe/Zurich"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "current_kubernetes_version", "1.30"),
+					),
+				},
+				{
+					// Removing the block keeps the current window, even when the KaaS is updated
+					Config: test.MustGetTestFile("schema", "resource_kaas_maintenance_removed.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "name", "test-renamed"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "maintenance_window.day", "tuesday"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "maintenance_window.start_time", "02:30"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "maintenance_window.timezone", "Europe/Zurich"),
+					),
+				},
+			},
+		},
+		"resource.kaas.maintenance_invalid_timezone": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_maintenance_invalid_timezone.tf"),
+					ExpectError: regexp.MustCompile(`unknown timezone "Europe/Nowhere"`),
+				},
+			},
+		},
+		"resource.kaas.maintenance_invalid_start_time": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_maintenance_invalid_start_time.tf"),
+					ExpectError: regexp.MustCompile(`HH:MM format`),
+				},
+			},
+		},
+		"resource.kaas.network_good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_kaas_network_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "network.pod_cidr", "10.100.0.0/16"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "network.service_cidr", "10.96.0.0/12"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "network.subnet_id", "6a1c3bb3-3cc1-4b0f-9d63-5d8f6e2c8a9e"),
+					),
+				},
+			},
+		},
+		"resource.kaas.network_overlap": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_network_overlap.tf"),
+					ExpectError: regexp.MustCompile(`service_cidr 10.96.0.0/12 overlaps pod_cidr 10.0.0.0/8`),
+				},
+			},
+		},
+		"resource.kaas.network_subnet_without_network": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_network_subnet_without_network.tf"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		},
+		"resource.kaas.oidc_invalid": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_oidc_invalid.tf"),
+					ExpectError: regexp.MustCompile(`issuer url should use the https scheme`),
+				},
+			},
+		},
+		"resource.kaas.params_good": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_kaas_params_good.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "apiserver.params.%", "3"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "apiserver.params.--profiling", "false"),
+					),
+				},
+			},
+		},
+		"resource.kaas.ip_filters_removed": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.MustGetTestFile("schema", "resource_kaas_ip_filters_inline.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "apiserver.ip_filters.#", "2"),
+						resource.TestCheckResourceAttr("infomaniak_kaas.kluster", "external_ip_filters", "false"),
+						checkMockedIpFilters("infomaniak_kaas.kluster", 2),
+					),
+				},
+				{
+					// Removing the inline ip filters clears them on the cluster
+					Config: test.MustGetTestFile("schema", "resource_kaas_ip_filters_removed.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckNoResourceAttr("infomaniak_kaas.kluster", "apiserver.ip_filters.#"),
+						checkMockedIpFilters("infomaniak_kaas.kluster", 0),
+					),
+				},
+			},
+		},
+		"resource.kaas.ip_filters_external_conflict": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_ip_filters_external_conflict.tf"),
+					ExpectError: regexp.MustCompile(`apiserver.ip_filters cannot be set when external_ip_filters is true`),
+				},
+			},
+		},
+		"resource.kaas.params_unknown_flag": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_params_unknown_flag.tf"),
+					ExpectError: regexp.MustCompile(`--enable-admision-plugins is not a kube-apiserver flag accepted`),
+				},
+			},
+		},
+		"resource.kaas.params_invalid_value": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_params_invalid_value.tf"),
+					ExpectError: regexp.MustCompile(`invalid value for --service-node-port-range`),
+				},
+			},
+		},
+		"resource.kaas.params_oidc_collision": {
+			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.MustGetTestFile("schema", "resource_kaas_params_oidc_collision.tf"),
+					ExpectError: regexp.MustCompile(`is managed by the oidc block`),
+				},
+			},
+		},
 		"resource.kaas.cant_specify_kubeconfig": {
 			ProtoV6ProviderFactories: provider.ProtoV6ProviderFactories(),
 			Steps: []resource.TestStep{
@@ -5,7 +5,12 @@ import "terraform-provider-infomaniak/internal/provider/registry"
 func Register() {
 	registry.RegisterResource(NewKaasResource)
 	registry.RegisterResource(NewKaasInstancePoolResource)
+	registry.RegisterResource(NewKaasIpFilterResource)
+	registry.RegisterResource(NewKaasKubeconfigRotationResource)
 
 	registry.RegisterDataSource(NewKaasDataSource)
 	registry.RegisterDataSource(NewKaasInstancePoolDataSource)
+	registry.RegisterDataSource(NewKaasNodesDataSource)
+	registry.RegisterDataSource(NewKaasListDataSource)
+	registry.RegisterDataSource(NewKaasInstancePoolsDataSource)
 }
@@ -0,0 +1,32 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+resource "infomaniak_kaas" "kluster" {
+  public_cloud_id = 42
+  public_cloud_project_id = 55
+
+  pack_name = "standard"
+  name = "by-name"
+  kubernetes_version = "1.30"
+  region = "dc5"
+}
+
+data "infomaniak_kaas" "kluster" {
+  depends_on = [
+    infomaniak_kaas.kluster
+  ]
+  public_cloud_id         = infomaniak_kaas.kluster.public_cloud_id
+  public_cloud_project_id = infomaniak_kaas.kluster.public_cloud_project_id
+  name                    = "by-name"
+}
@@ -0,0 +1,52 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+resource "infomaniak_kaas" "kluster" {
+  public_cloud_id = 42
+  public_cloud_project_id = 56
+
+  pack_name = "standard"
+  name = "test"
+  kubernetes_version = "1.30"
+  region = "dc5"
+}
+
+resource "infomaniak_kaas" "other" {
+  public_cloud_id = 42
+  public_cloud_project_id = 56
+
+  pack_name = "standard"
+  name = "other"
+  kubernetes_version = "1.31"
+  region = "dc5"
+}
+
+data "infomaniak_kaas_list" "all" {
+  depends_on = [
+    infomaniak_kaas.kluster,
+    infomaniak_kaas.other
+  ]
+  public_cloud_id         = 42
+  public_cloud_project_id = 56
+}
+
+data "infomaniak_kaas_list" "filtered" {
+  depends_on = [
+    infomaniak_kaas.kluster,
+    infomaniak_kaas.other
+  ]
+  public_cloud_id         = 42
+  public_cloud_project_id = 56
+  kubernetes_version      = "1.31"
+}
@@ -0,0 +1,47 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+
+
+resource "infomaniak_kaas" "kluster" {
+  public_cloud_id = 42
+  public_cloud_project_id = 54
+
+  pack_name = "standard"
+  name = "test"
+  kubernetes_version = "1.30"
+  region = "dc5"
+
+  apiserver = {
+    audit = {
+      policy_rules = [
+        {
+          level = "None"
+          users = ["system:kube-proxy"]
+          verbs = ["watch"]
+          resources = [
+            {
+              resources = ["endpoints", "services"]
+            }
+          ]
+        },
+        {
+          level       = "Metadata"
+          namespaces  = ["kube-system"]
+          omit_stages = ["RequestReceived"]
+        }
+      ]
+    }
+  }
+}
@@ -0,0 +1,18 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+resource "infomaniak_kaas_kubeconfig_rotation" "rotation" {
+  public_cloud_id         = 42
+  public_cloud_project_id = 54
+}
@@ -0,0 +1,29 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    infomaniak = {
+      source  = "Infomaniak/infomaniak"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "infomaniak" {
+  token = "fake-token"
+}
+resource "infomaniak_kaas" "kluster" {
+  public_cloud_id = 42
+  public_cloud_project_id = 54
+
+  pack_name = "standard"
+  name = "test"
+  kubernetes_version = "1.30"
+  region = "dc5"
+
+  network = {
+    pod_cidr     = "10.100.0.0/16"
+    network_id   = "2b4cbd4e-8f4e-4bdf-a5b4-0f6f4c7ad6b1"
+    subnet_id    = "6a1c3bb3-3cc1-4b0f-9d63-5d8f6e2c8a9e"
+  }
+}
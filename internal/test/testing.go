@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -61,3 +62,23 @@ func TestCheckGetFromState(name string, key string, out *string) func(s *terrafo
 		return fmt.Errorf("%s: Attribute '%s' expected to be set", name, key)
 	}
 }
+
+// GetIdsFromState parses the given integer attributes of a resource, in order, along with all its attributes
+func GetIdsFromState(s *terraform.State, name string, keys ...string) ([]int64, map[string]string, error) {
+	rs, ok := s.RootModule().Resources[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("resource %s not found", name)
+	}
+
+	attributes := rs.Primary.Attributes
+	ids := make([]int64, 0, len(keys))
+	for _, key := range keys {
+		id, err := strconv.ParseInt(attributes[key], 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: attribute %s: %w", name, key, err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, attributes, nil
+}
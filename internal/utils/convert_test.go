@@ -0,0 +1,55 @@
+package utils_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"terraform-provider-infomaniak/internal/dynamic"
+	"terraform-provider-infomaniak/internal/utils"
+)
+
+var _ = Describe("Dynamic numbers", func() {
+	DescribeTable("should round trip without losing precision",
+		func(number string) {
+			body := []byte(`{"setting":` + number + `}`)
+
+			dyn, err := dynamic.FromJSONImplied(body)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			converted, diags := utils.ConvertDynamicObjectToMapAny(dyn)
+			Expect(diags.HasError()).To(BeFalse())
+			Expect(converted).To(HaveKeyWithValue("setting", json.Number(number)))
+
+			encoded, err := dynamic.ToJSON(dyn)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(encoded)).To(MatchJSON(body))
+			Expect(string(encoded)).To(ContainSubstring(number))
+		},
+		Entry("byte size", "1000000000"),
+		Entry("large integer", "9007199254740993"),
+		Entry("integer beyond int64", "123456789012345678901234567890"),
+		Entry("negative integer", "-42"),
+		Entry("zero", "0"),
+		Entry("decimal", "0.1"),
+		Entry("long decimal", "3.141592653589793238462643383279"),
+	)
+
+	It("should detect integers", func() {
+		dyn, err := dynamic.FromJSONImplied([]byte(`{"integer":1e9,"decimal":2.5}`))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		attributes := dyn.UnderlyingValue().(basetypes.ObjectValue).Attributes()
+		Expect(attributes["integer"].(basetypes.NumberValue).ValueBigFloat().IsInt()).To(BeTrue())
+		Expect(attributes["decimal"].(basetypes.NumberValue).ValueBigFloat().IsInt()).To(BeFalse())
+
+		encoded, err := dynamic.ToJSON(dyn)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(encoded)).To(MatchJSON(`{"integer":1000000000,"decimal":2.5}`))
+		Expect(string(encoded)).To(ContainSubstring("1000000000"))
+	})
+
+})
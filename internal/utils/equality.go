@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// SemanticallyEqual tells whether two terraform values hold the same data, regardless of how it is typed.
+// Objects and maps are compared key by key, tuples and lists element by element and sets without order.
+// Numbers are equal to strings holding the same number (100 = "100" = "1e2") and booleans are equal to
+// strings holding them (true = "true" = "ON"), two strings are only equal when written the same.
+// Unknown values are never equal to anything.
+func SemanticallyEqual(a, b attr.Value) bool {
+	a, b = underlyingValue(a), underlyingValue(b)
+
+	if a == nil || b == nil || a.IsNull() || b.IsNull() {
+		return isNullValue(a) && isNullValue(b)
+	}
+	if a.IsUnknown() || b.IsUnknown() {
+		return false
+	}
+
+	if aElements, isCollection := collectionElements(a); isCollection {
+		bElements, isCollection := collectionElements(b)
+		if !isCollection || len(aElements) != len(bElements) {
+			return false
+		}
+		if isSet(a) || isSet(b) {
+			return unorderedEqual(aElements, bElements)
+		}
+		for i := range aElements {
+			if !SemanticallyEqual(aElements[i], bElements[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if aAttributes, isObject := objectAttributes(a); isObject {
+		bAttributes, isObject := objectAttributes(b)
+		if !isObject || len(aAttributes) != len(bAttributes) {
+			return false
+		}
+		for key, aValue := range aAttributes {
+			bValue, found := bAttributes[key]
+			if !found || !SemanticallyEqual(aValue, bValue) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return scalarEqual(a, b)
+}
+
+func underlyingValue(value attr.Value) attr.Value {
+	if dynamic, isDynamic := value.(basetypes.DynamicValue); isDynamic {
+		if dynamic.IsNull() || dynamic.IsUnknown() {
+			return dynamic
+		}
+		return underlyingValue(dynamic.UnderlyingValue())
+	}
+	return value
+}
+
+func isNullValue(value attr.Value) bool {
+	return value == nil || value.IsNull()
+}
+
+func isSet(value attr.Value) bool {
+	_, isSet := value.(basetypes.SetValue)
+	return isSet
+}
+
+func collectionElements(value attr.Value) ([]attr.Value, bool) {
+	switch typed := value.(type) {
+	case basetypes.TupleValue:
+		return typed.Elements(), true
+	case basetypes.ListValue:
+		return typed.Elements(), true
+	case basetypes.SetValue:
+		return typed.Elements(), true
+	}
+	return nil, false
+}
+
+func objectAttributes(value attr.Value) (map[string]attr.Value, bool) {
+	switch typed := value.(type) {
+	case basetypes.ObjectValue:
+		return typed.Attributes(), true
+	case basetypes.MapValue:
+		return typed.Elements(), true
+	}
+	return nil, false
+}
+
+// unorderedEqual matches every element of a with a distinct element of b
+func unorderedEqual(a, b []attr.Value) bool {
+	matched := make([]bool, len(b))
+	for _, aValue := range a {
+		found := false
+		for i, bValue := range b {
+			if !matched[i] && SemanticallyEqual(aValue, bValue) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func scalarEqual(a, b attr.Value) bool {
+	aNumber, aIsNumber := numberValue(a)
+	bNumber, bIsNumber := numberValue(b)
+	switch {
+	case aIsNumber && bIsNumber:
+		return aNumber.Cmp(bNumber) == 0
+	case aIsNumber:
+		return numberStringEqual(aNumber, b)
+	case bIsNumber:
+		return numberStringEqual(bNumber, a)
+	}
+
+	aString, aIsString := a.(basetypes.StringValue)
+	bString, bIsString := b.(basetypes.StringValue)
+	if aIsString && bIsString {
+		return aString.ValueString() == bString.ValueString()
+	}
+
+	aBool, aIsBool := boolValue(a)
+	bBool, bIsBool := boolValue(b)
+	return aIsBool && bIsBool && aBool == bBool
+}
+
+func numberValue(value attr.Value) (*big.Float, bool) {
+	switch typed := value.(type) {
+	case basetypes.NumberValue:
+		return typed.ValueBigFloat(), true
+	case basetypes.Int64Value:
+		return new(big.Float).SetInt64(typed.ValueInt64()), true
+	case basetypes.Int32Value:
+		return new(big.Float).SetInt64(int64(typed.ValueInt32())), true
+	case basetypes.Float64Value:
+		return big.NewFloat(typed.ValueFloat64()), true
+	case basetypes.Float32Value:
+		return big.NewFloat(float64(typed.ValueFloat32())), true
+	}
+	return nil, false
+}
+
+// numberStringEqual tells whether value is a string holding number. The string is read with the
+// precision of number, so that "0.1" equals a 0.1 float64 as well as a 0.1 terraform number
+func numberStringEqual(number *big.Float, value attr.Value) bool {
+	str, isString := value.(basetypes.StringValue)
+	if !isString {
+		return false
+	}
+
+	parsed, _, err := big.ParseFloat(strings.TrimSpace(str.ValueString()), 10, number.Prec(), big.ToNearestEven)
+	return err == nil && parsed.Cmp(number) == 0
+}
+
+// boolValue reads a boolean, or a string holding a boolean
+func boolValue(value attr.Value) (bool, bool) {
+	switch typed := value.(type) {
+	case basetypes.BoolValue:
+		return typed.ValueBool(), true
+	case basetypes.StringValue:
+		switch strings.ToLower(strings.TrimSpace(typed.ValueString())) {
+		case "true", "on":
+			return true, true
+		case "false", "off":
+			return false, true
+		}
+	}
+	return false, false
+}
@@ -0,0 +1,129 @@
+package utils_test
+
+import (
+	"context"
+	"math/big"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-infomaniak/internal/utils"
+)
+
+func tuple(values ...attr.Value) attr.Value {
+	elementTypes := make([]attr.Type, 0, len(values))
+	for _, value := range values {
+		elementTypes = append(elementTypes, value.Type(context.Background()))
+	}
+	return types.TupleValueMust(elementTypes, values)
+}
+
+func object(values map[string]attr.Value) attr.Value {
+	attributeTypes := make(map[string]attr.Type, len(values))
+	for key, value := range values {
+		attributeTypes[key] = value.Type(context.Background())
+	}
+	return types.ObjectValueMust(attributeTypes, values)
+}
+
+func number(value float64) attr.Value {
+	return types.NumberValue(big.NewFloat(value))
+}
+
+var _ = Describe("SemanticallyEqual", func() {
+	DescribeTable("comparing values",
+		func(a, b attr.Value, expected bool) {
+			Expect(utils.SemanticallyEqual(a, b)).To(Equal(expected))
+			Expect(utils.SemanticallyEqual(b, a)).To(Equal(expected))
+		},
+		Entry("same strings", types.StringValue("value"), types.StringValue("value"), true),
+		Entry("different strings", types.StringValue("value"), types.StringValue("other"), false),
+		Entry("strings holding the same number", types.StringValue("100"), types.StringValue("1e2"), false),
+		Entry("number and string", number(100), types.StringValue("100"), true),
+		Entry("number and exponent string", number(100), types.StringValue("1e2"), true),
+		Entry("number and different string", number(100), types.StringValue("101"), false),
+		Entry("number and non numeric string", number(100), types.StringValue("a lot"), false),
+		Entry("int64 and number", types.Int64Value(100), number(100), true),
+		Entry("float64 and string", types.Float64Value(0.1), types.StringValue("0.1"), true),
+		Entry("rounded number and exact decimal string", types.NumberValue(big.NewFloat(0.1).SetPrec(512)), types.StringValue("0.1"), false),
+		Entry("fraction and integer", number(2.5), number(2), false),
+		Entry("bool and string", types.BoolValue(true), types.StringValue("true"), true),
+		Entry("bool and on", types.BoolValue(true), types.StringValue("ON"), true),
+		Entry("bool and off", types.BoolValue(false), types.StringValue("off"), true),
+		Entry("bool and opposite string", types.BoolValue(false), types.StringValue("true"), false),
+		Entry("bool and number", types.BoolValue(true), number(1), false),
+		Entry("nulls", types.StringNull(), types.NumberNull(), true),
+		Entry("null and value", types.StringNull(), types.StringValue(""), false),
+		Entry("unknowns", types.StringUnknown(), types.StringUnknown(), false),
+		Entry("tuples",
+			tuple(types.StringValue("a"), number(1)),
+			tuple(types.StringValue("a"), types.StringValue("1")),
+			true,
+		),
+		Entry("tuples in another order",
+			tuple(types.StringValue("a"), types.StringValue("b")),
+			tuple(types.StringValue("b"), types.StringValue("a")),
+			false,
+		),
+		Entry("tuples of different lengths",
+			tuple(types.StringValue("a")),
+			tuple(types.StringValue("a"), types.StringValue("b")),
+			false,
+		),
+		Entry("tuple and list",
+			tuple(types.StringValue("a")),
+			types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			true,
+		),
+		Entry("sets in another order",
+			types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			tuple(types.StringValue("b"), types.StringValue("a")),
+			true,
+		),
+		Entry("nested objects",
+			object(map[string]attr.Value{
+				"limits": object(map[string]attr.Value{"max": number(300), "enabled": types.BoolValue(true)}),
+			}),
+			object(map[string]attr.Value{
+				"limits": object(map[string]attr.Value{"max": types.StringValue("300"), "enabled": types.StringValue("true")}),
+			}),
+			true,
+		),
+		Entry("nested objects with a different value",
+			object(map[string]attr.Value{
+				"limits": object(map[string]attr.Value{"max": number(300)}),
+			}),
+			object(map[string]attr.Value{
+				"limits": object(map[string]attr.Value{"max": number(200)}),
+			}),
+			false,
+		),
+		Entry("nested objects with a missing key",
+			object(map[string]attr.Value{
+				"limits": object(map[string]attr.Value{"max": number(300), "min": number(1)}),
+			}),
+			object(map[string]attr.Value{
+				"limits": object(map[string]attr.Value{"max": number(300)}),
+			}),
+			false,
+		),
+		Entry("object and map",
+			object(map[string]attr.Value{"key": types.StringValue("value")}),
+			types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")}),
+			true,
+		),
+		Entry("dynamic values",
+			types.DynamicValue(object(map[string]attr.Value{"max": number(300)})),
+			object(map[string]attr.Value{"max": types.StringValue("300")}),
+			true,
+		),
+		Entry("null dynamic and null object",
+			types.DynamicNull(),
+			types.ObjectNull(map[string]attr.Type{}),
+			true,
+		),
+	)
+})
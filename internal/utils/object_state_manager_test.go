@@ -2,6 +2,7 @@ package utils_test
 
 import (
 	"context"
+	"math/big"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -303,4 +304,52 @@ var _ = Describe("ObjectStateManager", func() {
 			Expect(effectiveElements["setting2"]).To(Equal(types.StringValue("value2")))
 		})
 	})
+
+	Context("when API returns user managed settings typed differently", func() {
+		It("should keep user defined settings as written and detect nested changes", func() {
+			newEffectiveObj, _ := types.ObjectValue(map[string]attr.Type{
+				"setting1": types.NumberType,
+				"setting2": types.ObjectType{AttrTypes: map[string]attr.Type{"nested": types.NumberType}},
+			}, map[string]attr.Value{
+				"setting1": types.NumberValue(big.NewFloat(100)),
+				"setting2": types.ObjectValueMust(map[string]attr.Type{"nested": types.NumberType}, map[string]attr.Value{
+					"nested": types.NumberValue(big.NewFloat(2)),
+				}),
+			})
+			newEffective := types.DynamicValue(newEffectiveObj)
+
+			stateEffectiveObj, _ := types.ObjectValue(map[string]attr.Type{
+				"setting1": types.StringType,
+				"setting2": types.ObjectType{AttrTypes: map[string]attr.Type{"nested": types.StringType}},
+			}, map[string]attr.Value{
+				"setting1": types.StringValue("100"),
+				"setting2": types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{
+					"nested": types.StringValue("1"),
+				}),
+			})
+			stateEffective := types.DynamicValue(stateEffectiveObj)
+
+			userDefinedObj, _ := types.ObjectValue(map[string]attr.Type{
+				"setting1": types.StringType,
+			}, map[string]attr.Value{
+				"setting1": types.StringValue("100"),
+			})
+			userDefined := types.DynamicValue(userDefinedObj)
+
+			effectiveMap, localMap, diags := utils.ObjectStateManager(ctx, newEffective, stateEffective, userDefined)
+
+			Expect(diags.HasError()).To(BeFalse())
+
+			// Check localMap (userDefined) - "100" is the same as 100, the nested change comes from elsewhere
+			localUnderlying := localMap.UnderlyingValue().(basetypes.ObjectValue)
+			localElements := localUnderlying.Attributes()
+			Expect(localElements).To(HaveLen(2))
+			Expect(localElements["setting1"]).To(Equal(types.StringValue("100")))
+			Expect(localElements["setting2"]).To(Equal(newEffectiveObj.Attributes()["setting2"])) // Updated
+
+			// Check effectiveMap (stateEffective updated)
+			effectiveUnderlying := effectiveMap.UnderlyingValue().(basetypes.ObjectValue)
+			Expect(effectiveUnderlying.Attributes()).To(Equal(newEffectiveObj.Attributes()))
+		})
+	})
 })
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MergeTags computes the effective tags of a resource, resource tags take precedence over the default ones.
+// The result is unknown as long as the resource tags are not fully known.
+func MergeTags(ctx context.Context, defaultTags map[string]string, tags types.Map) (types.Map, diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	merged := maps.Clone(defaultTags)
+	if merged == nil {
+		merged = make(map[string]string)
+	}
+
+	for key, value := range tags.Elements() {
+		if value.IsUnknown() {
+			return types.MapUnknown(types.StringType), nil
+		}
+		if str, ok := value.(types.String); ok && !str.IsNull() {
+			merged[key] = str.ValueString()
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, merged)
+}
+
+// TagsFromApi splits the tags returned by the API into the tags managed by the resource and the effective ones.
+// Tags coming from the defaults are only kept in the resource tags when they were configured there or when their value differs.
+func TagsFromApi(apiTags map[string]string, defaultTags map[string]string, configured types.Map) (tags types.Map, tagsAll types.Map) {
+	all := make(map[string]attr.Value, len(apiTags))
+	own := make(map[string]attr.Value)
+
+	configuredElements := configured.Elements()
+	for key, value := range apiTags {
+		all[key] = types.StringValue(value)
+
+		_, isConfigured := configuredElements[key]
+		defaultValue, isDefault := defaultTags[key]
+		if isConfigured || !isDefault || defaultValue != value {
+			own[key] = types.StringValue(value)
+		}
+	}
+
+	tagsAll = types.MapValueMust(types.StringType, all)
+	if len(own) == 0 && configured.IsNull() {
+		return types.MapNull(types.StringType), tagsAll
+	}
+
+	return types.MapValueMust(types.StringType, own), tagsAll
+}
+
+// TagsToApi converts the effective tags to the API representation
+func TagsToApi(ctx context.Context, tagsAll types.Map) (map[string]string, diag.Diagnostics) {
+	tags := make(map[string]string)
+	if tagsAll.IsNull() || tagsAll.IsUnknown() {
+		return tags, nil
+	}
+
+	diags := tagsAll.ElementsAs(ctx, &tags, false)
+	return tags, diags
+}
@@ -0,0 +1,87 @@
+package utils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-infomaniak/internal/utils"
+)
+
+var _ = Describe("Tags", func() {
+	var (
+		ctx         context.Context
+		defaultTags map[string]string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		defaultTags = map[string]string{"team": "platform", "env": "prod"}
+	})
+
+	Context("MergeTags", func() {
+		It("should let resource tags take precedence over default tags", func() {
+			tags := types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":  types.StringValue("staging"),
+				"name": types.StringValue("db"),
+			})
+
+			merged, diags := utils.MergeTags(ctx, defaultTags, tags)
+			Expect(diags.HasError()).To(BeFalse())
+			Expect(merged.Elements()).To(Equal(map[string]attr.Value{
+				"team": types.StringValue("platform"),
+				"env":  types.StringValue("staging"),
+				"name": types.StringValue("db"),
+			}))
+		})
+
+		It("should return the default tags when the resource has none", func() {
+			merged, diags := utils.MergeTags(ctx, defaultTags, types.MapNull(types.StringType))
+			Expect(diags.HasError()).To(BeFalse())
+			Expect(merged.Elements()).To(HaveLen(2))
+		})
+
+		It("should be unknown while the resource tags are unknown", func() {
+			tags := types.MapValueMust(types.StringType, map[string]attr.Value{
+				"name": types.StringUnknown(),
+			})
+
+			merged, _ := utils.MergeTags(ctx, defaultTags, tags)
+			Expect(merged.IsUnknown()).To(BeTrue())
+		})
+	})
+
+	Context("TagsFromApi", func() {
+		It("should only keep the default tags in tags_all", func() {
+			apiTags := map[string]string{"team": "platform", "env": "prod", "name": "db"}
+
+			tags, tagsAll := utils.TagsFromApi(apiTags, defaultTags, types.MapNull(types.StringType))
+			Expect(tags.Elements()).To(Equal(map[string]attr.Value{"name": types.StringValue("db")}))
+			Expect(tagsAll.Elements()).To(HaveLen(3))
+		})
+
+		It("should keep overridden and explicitly configured default tags", func() {
+			apiTags := map[string]string{"team": "platform", "env": "staging"}
+			configured := types.MapValueMust(types.StringType, map[string]attr.Value{
+				"team": types.StringValue("platform"),
+			})
+
+			tags, _ := utils.TagsFromApi(apiTags, defaultTags, configured)
+			Expect(tags.Elements()).To(Equal(map[string]attr.Value{
+				"team": types.StringValue("platform"),
+				"env":  types.StringValue("staging"),
+			}))
+		})
+
+		It("should keep tags null when nothing is left", func() {
+			tags, tagsAll := utils.TagsFromApi(nil, defaultTags, types.MapNull(types.StringType))
+			Expect(tags.IsNull()).To(BeTrue())
+			Expect(tagsAll.IsNull()).To(BeFalse())
+			Expect(tagsAll.Elements()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-infomaniak/internal/apis/helpers"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultDeleteTimeout bounds how long Delete waits for a resource to be removed when no timeout is configured
+const DefaultDeleteTimeout = 30 * time.Minute
+
+// DeletePollInterval is how often WaitUntilDeleted checks whether the resource is gone
+var DeletePollInterval = 5 * time.Second
+
+// Timeouts holds the timeouts attribute of resources waiting on asynchronous operations
+type Timeouts struct {
+	Delete types.String `tfsdk:"delete"`
+}
+
+// TimeoutsAttribute is the schema of the timeouts attribute
+func TimeoutsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "How long to wait for asynchronous operations",
+		Attributes: map[string]schema.Attribute{
+			"delete": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("How long to wait for the deletion, as a duration such as `45m` or `1h30m`, defaults to `%s`", DefaultDeleteTimeout),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+		},
+	}
+}
+
+// DeleteTimeout returns the configured delete timeout, or DefaultDeleteTimeout
+func (timeouts *Timeouts) DeleteTimeout() time.Duration {
+	if timeouts == nil || timeouts.Delete.IsNull() || timeouts.Delete.IsUnknown() {
+		return DefaultDeleteTimeout
+	}
+
+	timeout, err := time.ParseDuration(timeouts.Delete.ValueString())
+	if err != nil {
+		return DefaultDeleteTimeout
+	}
+	return timeout
+}
+
+// WaitUntilDeleted polls isDeleted until it reports the resource as deleted or the timeout expires.
+// A not found error from isDeleted means the resource is deleted, deletion is asynchronous on the API side.
+func WaitUntilDeleted(ctx context.Context, timeout time.Duration, isDeleted func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(DeletePollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("still not deleted after %s: %w", timeout, ctx.Err())
+		case <-ticker.C:
+			deleted, err := isDeleted()
+			if errors.Is(err, helpers.ErrNotFound) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+	}
+}
+
+// durationValidator ensures a string is a duration understood by time.ParseDuration
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a duration such as 45m or 1h30m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && timeout <= 0 {
+		err = fmt.Errorf("the duration must be positive")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("%q is not a valid duration such as 45m or 1h30m: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,76 @@
+package utils_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-infomaniak/internal/apis/helpers"
+	"terraform-provider-infomaniak/internal/utils"
+)
+
+var _ = Describe("Timeouts", func() {
+	Context("DeleteTimeout", func() {
+		It("should default when not configured", func() {
+			var timeouts *utils.Timeouts
+			Expect(timeouts.DeleteTimeout()).To(Equal(utils.DefaultDeleteTimeout))
+			Expect((&utils.Timeouts{Delete: types.StringNull()}).DeleteTimeout()).To(Equal(utils.DefaultDeleteTimeout))
+		})
+
+		It("should read the configured duration", func() {
+			timeouts := &utils.Timeouts{Delete: types.StringValue("1h30m")}
+			Expect(timeouts.DeleteTimeout()).To(Equal(90 * time.Minute))
+		})
+	})
+
+	Context("WaitUntilDeleted", func() {
+		var previousInterval time.Duration
+
+		BeforeEach(func() {
+			previousInterval = utils.DeletePollInterval
+			utils.DeletePollInterval = time.Millisecond
+		})
+
+		AfterEach(func() {
+			utils.DeletePollInterval = previousInterval
+		})
+
+		It("should poll until the resource is deleted", func() {
+			calls := 0
+			err := utils.WaitUntilDeleted(context.Background(), time.Second, func() (bool, error) {
+				calls++
+				return calls == 3, nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(calls).To(Equal(3))
+		})
+
+		It("should consider a not found resource as deleted", func() {
+			err := utils.WaitUntilDeleted(context.Background(), time.Second, func() (bool, error) {
+				return false, helpers.NotFoundError(nil)
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should return other errors", func() {
+			err := utils.WaitUntilDeleted(context.Background(), time.Second, func() (bool, error) {
+				return false, fmt.Errorf("internal error")
+			})
+			Expect(err).Should(MatchError("internal error"))
+		})
+
+		It("should give up after the timeout", func() {
+			err := utils.WaitUntilDeleted(context.Background(), 20*time.Millisecond, func() (bool, error) {
+				return false, nil
+			})
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(err).Should(MatchError(ContainSubstring("still not deleted after 20ms")))
+		})
+	})
+})
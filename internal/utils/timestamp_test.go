@@ -0,0 +1,18 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"terraform-provider-infomaniak/internal/utils"
+)
+
+var _ = Describe("TimestampValue", func() {
+	It("should format unix timestamps as RFC 3339 in UTC", func() {
+		Expect(utils.TimestampValue(1700000000).ValueString()).To(Equal("2023-11-14T22:13:20Z"))
+	})
+
+	It("should return null for unset timestamps", func() {
+		Expect(utils.TimestampValue(0).IsNull()).To(BeTrue())
+	})
+})
@@ -1,10 +1,12 @@
 package utils
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"terraform-provider-infomaniak/internal/dynamic"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,27 +29,18 @@ func ObjectStateManager(ctx context.Context, newEffective types.Dynamic, stateEf
 	diags.Append(d...)
 
 	for incomingEffectiveKey, incomingEffectiveValue := range incomingFromApi {
-		_, localManagerUseKey := local[incomingEffectiveKey]
-		if localManagerUseKey {
+		localValue, localManagerUseKey := local[incomingEffectiveKey]
+		// Keep the value as the user wrote it when the API returns it typed differently, e.g. "100" and 100
+		if localManagerUseKey && !SemanticallyEqual(localValue, incomingEffectiveValue) {
 			local[incomingEffectiveKey] = incomingEffectiveValue
 		}
 	}
 
 	for incomingEffectiveKey, incomingEffectiveValue := range incomingFromApi {
 		stateEffectiveValue, stateEffectiveUseKey := incomingFromState[incomingEffectiveKey]
-		if stateEffectiveUseKey {
-			// The user changed the value from an other source than terraform
-			stateEffectiveTfValue, err := stateEffectiveValue.ToTerraformValue(ctx)
-			if err != nil {
-				diags.AddError("could not get terraform value", "could not get a state effective value")
-			}
-			incomingEffectiveTfValue, err := incomingEffectiveValue.ToTerraformValue(ctx)
-			if err != nil {
-				diags.AddError("could not get terraform value", "could not get an incoming from api effective value")
-			}
-			if !stateEffectiveTfValue.Equal(incomingEffectiveTfValue) {
-				local[incomingEffectiveKey] = incomingEffectiveValue
-			}
+		// The user changed the value from an other source than terraform
+		if stateEffectiveUseKey && !SemanticallyEqual(stateEffectiveValue, incomingEffectiveValue) {
+			local[incomingEffectiveKey] = incomingEffectiveValue
 		}
 		incomingFromState[incomingEffectiveKey] = incomingEffectiveValue
 	}
@@ -96,7 +89,10 @@ func ConvertDynamicObjectToMapAny(dyn types.Dynamic) (map[string]any, diag.Diagn
 		diags.AddError("json error", fmt.Sprintf("could not convert dynamic to json: %v", err))
 	}
 
-	err = json.Unmarshal(body, &converted)
+	// Numbers are decoded as json.Number to be sent to the API as written
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err = decoder.Decode(&converted)
 	if err != nil {
 		diags.AddError("json error", fmt.Sprintf("could not unmarshall json: %v", err))
 	}
@@ -128,21 +124,10 @@ func ConvertMapToDynamicObject(ctx context.Context, toconvert map[string]attr.Va
 	return dyn, diags
 }
 
-// ConvertIntsToStrings will transform comparable types
-// E.g: 100 = "100"
-func ConvertIntsToStrings(input map[string]any) map[string]any {
-	output := make(map[string]any)
-	for key, value := range input {
-		switch typedValue := value.(type) {
-		case uint, uint8, uint16, uint32, uint64, int, int8, int16, int32, int64, float32, float64:
-			output[key] = fmt.Sprint(typedValue)
-		case map[string]any:
-			newOutput := make(map[string]any)
-			ConvertIntsToStrings(typedValue)
-			output[key] = newOutput
-		default:
-			output[key] = typedValue
-		}
+// TimestampValue converts an API unix timestamp to an RFC 3339 string, a zero timestamp means unset
+func TimestampValue(timestamp uint64) types.String {
+	if timestamp == 0 {
+		return types.StringNull()
 	}
-	return output
+	return types.StringValue(time.Unix(int64(timestamp), 0).UTC().Format(time.RFC3339))
 }